@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+const benchUsage = `usage: aoc bench <command> [--year year] [--count n] [--cache-dir dir]
+
+commands:
+  run [day|all]           run the benchmarks and store the results
+                          under the current git commit
+  list                    list the stored results
+  compare [old [new]]     compare the results of two commits, by
+                          default the two most recent ones
+`
+
+const module = "github.com/wincus/adventofcode2024/"
+
+func bench(args []string) error {
+
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), benchUsage) }
+	count := fs.Int("count", 5, "number of times to run each benchmark")
+	year := yearFlag(fs)
+	dir := cacheDirFlag(fs)
+
+	positional, err := parseArgs(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	setCacheDir(*dir)
+
+	c, err := common.DefaultCache()
+
+	if err != nil {
+		return err
+	}
+
+	switch positional[0] {
+	case "run":
+		s := "all"
+
+		if len(positional) > 1 {
+			s = positional[1]
+		}
+
+		return benchRun(c, *year, s, *count)
+
+	case "list":
+		runs, err := benchRuns(c, *year)
+
+		if err != nil {
+			return err
+		}
+
+		for _, r := range runs {
+			fmt.Println(r)
+		}
+
+	case "compare":
+		return benchCompare(c, *year, positional[1:])
+
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	return nil
+}
+
+// benchRun runs the benchmarks of the selected days and of the
+// shared helpers, storing their output under the current commit
+func benchRun(c *common.Cache, year int, s string, count int) error {
+
+	pkgs := []string{"./internal/common"}
+
+	if s == "all" {
+		pkgs = append(pkgs, fmt.Sprintf("./internal/%v/...", year))
+	} else {
+		day, err := strconv.Atoi(s)
+
+		if err != nil {
+			return fmt.Errorf("invalid day %q", s)
+		}
+
+		pkgs = append(pkgs, fmt.Sprintf("./internal/%v/day%v", year, day))
+	}
+
+	commit := gitCommit()
+
+	var out bytes.Buffer
+
+	args := append([]string{"test", "-run", "^$", "-bench", ".", "-benchmem", "-count", strconv.Itoa(count)}, pkgs...)
+
+	cmd := exec.Command("go", args...)
+	cmd.Stdout = io.MultiWriter(os.Stdout, &out)
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("benchmarks failed: %v", err)
+	}
+
+	path := c.BenchPath(year, commit)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, out.Bytes(), 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("stored results for %v in %v\n", commit, path)
+
+	return nil
+}
+
+// benchCompare prints the change of every benchmark between the
+// runs of two commits
+func benchCompare(c *common.Cache, year int, commits []string) error {
+
+	if len(commits) < 2 {
+
+		runs, err := benchRuns(c, year)
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case len(commits) == 1 && len(runs) > 0:
+			commits = append(commits, runs[len(runs)-1])
+		case len(commits) == 0 && len(runs) > 1:
+			commits = runs[len(runs)-2:]
+		default:
+			return errors.New("not enough stored results to compare")
+		}
+	}
+
+	var results [2]common.BenchResults
+
+	for i, commit := range commits[:2] {
+
+		f, err := os.Open(c.BenchPath(year, commit))
+
+		if err != nil {
+			return fmt.Errorf("no results for %v: %v", commit, err)
+		}
+
+		results[i], err = common.ParseBench(f)
+		f.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "NAME\tUNIT\t%v\t%v\tDELTA\n", commits[0], commits[1])
+
+	for _, d := range common.CompareBench(results[0], results[1]) {
+
+		delta := "~"
+
+		switch {
+		case math.IsInf(d.Change, 1):
+			delta = "+inf"
+		case d.Significant():
+			delta = fmt.Sprintf("%+.2f%%", d.Change*100)
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v ±%.0f%%\t%v ±%.0f%%\t%v\n",
+			strings.TrimPrefix(d.Name, module), d.Unit, formatValue(d.Old), d.OldVar*100, formatValue(d.New), d.NewVar*100, delta)
+	}
+
+	return w.Flush()
+}
+
+// formatValue prints large values without exponent
+func formatValue(v float64) string {
+
+	if v >= 100 {
+		return fmt.Sprintf("%.0f", v)
+	}
+
+	return fmt.Sprintf("%.4g", v)
+}
+
+// benchRuns returns the commits with stored results for year,
+// oldest first
+func benchRuns(c *common.Cache, year int) ([]string, error) {
+
+	dir := filepath.Dir(c.BenchPath(year, "x"))
+
+	entries, err := os.ReadDir(dir)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	type run struct {
+		commit string
+		info   fs.FileInfo
+	}
+
+	var runs []run
+
+	for _, e := range entries {
+
+		commit, ok := strings.CutSuffix(e.Name(), ".txt")
+
+		if !ok {
+			continue
+		}
+
+		info, err := e.Info()
+
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, run{commit, info})
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].info.ModTime().Before(runs[j].info.ModTime())
+	})
+
+	var commits []string
+
+	for _, r := range runs {
+		commits = append(commits, r.commit)
+	}
+
+	return commits, nil
+}
+
+// gitCommit returns the short hash of HEAD, marked as dirty when
+// the tree has uncommitted changes
+func gitCommit() string {
+
+	out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output()
+
+	if err != nil {
+		return "unknown"
+	}
+
+	commit := strings.TrimSpace(string(out))
+
+	status, err := exec.Command("git", "status", "--porcelain").Output()
+
+	if err == nil && len(bytes.TrimSpace(status)) > 0 {
+		commit += "-dirty"
+	}
+
+	return commit
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+const cacheUsage = `usage: aoc cache <command> [--year year] [--cache-dir dir]
+
+commands:
+  dir                     print the cache directory
+  list                    list every cached input
+  show <day>              describe the cached input for day of --year
+  invalidate <day|all>    remove the cached input for day of --year
+  prune                   remove inputs fetched with other sessions
+`
+
+func cache(args []string) error {
+
+	fs := flag.NewFlagSet("cache", flag.ExitOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), cacheUsage) }
+	year := yearFlag(fs)
+	dir := cacheDirFlag(fs)
+
+	positional, err := parseArgs(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	setCacheDir(*dir)
+
+	if positional[0] == "dir" {
+		d, err := common.CacheDir()
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(d)
+
+		return nil
+	}
+
+	c, err := common.DefaultCache()
+
+	if err != nil {
+		return err
+	}
+
+	switch positional[0] {
+	case "list":
+		entries, err := c.List()
+
+		if err != nil {
+			return err
+		}
+
+		printEntries(c, entries)
+
+	case "show":
+		if len(positional) != 2 {
+			return fmt.Errorf("expected a day number")
+		}
+
+		day, err := strconv.Atoi(positional[1])
+
+		if err != nil {
+			return fmt.Errorf("invalid day %q", positional[1])
+		}
+
+		data, err := c.Get(*year, day)
+
+		if err != nil {
+			return fmt.Errorf("day %v: %w", day, err)
+		}
+
+		info, err := os.Stat(c.Path(*year, day))
+
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+		fmt.Fprintf(w, "path:\t%v\n", c.Path(*year, day))
+		fmt.Fprintf(w, "size:\t%v bytes\n", info.Size())
+		fmt.Fprintf(w, "lines:\t%v\n", len(common.Trim(data)))
+		fmt.Fprintf(w, "fetched:\t%v\n", info.ModTime().Format(time.RFC3339))
+		w.Flush()
+
+	case "invalidate":
+		if len(positional) != 2 {
+			return fmt.Errorf("expected a day number or all")
+		}
+
+		days, err := cachedDays(c, *year, positional[1])
+
+		if err != nil {
+			return err
+		}
+
+		for _, day := range days {
+			if err := c.Invalidate(*year, day); err != nil {
+				return fmt.Errorf("day %v: %w", day, err)
+			}
+
+			fmt.Printf("invalidated day %v\n", day)
+		}
+
+	case "prune":
+		pruned, err := c.Prune()
+
+		if err != nil {
+			return err
+		}
+
+		printEntries(c, pruned)
+
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	return nil
+}
+
+// cachedDays returns the days of year selected by s, either a single
+// day number or all for every day cached for the current session
+func cachedDays(c *common.Cache, year int, s string) ([]int, error) {
+
+	if s != "all" {
+		n, err := strconv.Atoi(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid day %q", s)
+		}
+
+		return []int{n}, nil
+	}
+
+	entries, err := c.List()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var days []int
+
+	for _, e := range entries {
+		if e.Year == year && e.Account == c.Account {
+			days = append(days, e.Day)
+		}
+	}
+
+	if len(days) == 0 {
+		return nil, errors.New("nothing cached")
+	}
+
+	return days, nil
+}
+
+func printEntries(c *common.Cache, entries []common.CacheEntry) {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "YEAR\tDAY\tACCOUNT\tSIZE\tFETCHED")
+
+	for _, e := range entries {
+
+		account := e.Account
+
+		if account == c.Account {
+			account += " (current)"
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", e.Year, e.Day, account, e.Size, e.ModTime.Format(time.RFC3339))
+	}
+
+	w.Flush()
+}
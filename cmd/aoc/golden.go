@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+const goldenUsage = `usage: aoc golden <command> [--year year] [--part 1|2|all] [--cache-dir dir]
+
+commands:
+  list                    list the golden answers
+  record <day|all>        store the current answers as golden
+  verify [day|all]        check the answers against the golden ones
+`
+
+func golden(args []string) error {
+
+	fs := flag.NewFlagSet("golden", flag.ExitOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), goldenUsage) }
+	part := fs.String("part", "all", "part to record or verify: 1, 2 or all")
+	timeout := timeoutFlag(fs)
+	year := yearFlag(fs)
+	dir := cacheDirFlag(fs)
+
+	positional, err := parseArgs(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	setCacheDir(*dir)
+
+	parts, err := parseParts(*part)
+
+	if err != nil {
+		return err
+	}
+
+	c, err := common.DefaultCache()
+
+	if err != nil {
+		return err
+	}
+
+	g, err := common.LoadGolden(c.GoldenPath(*year))
+
+	if err != nil {
+		return err
+	}
+
+	switch positional[0] {
+	case "list":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+		fmt.Fprintln(w, "DAY\tPART\tANSWER")
+
+		for _, day := range common.Days(*year) {
+			for _, p := range parts {
+				if a, ok := g.Get(day, p); ok {
+					fmt.Fprintf(w, "%v\t%v\t%v\n", day, p, a)
+				}
+			}
+		}
+
+		w.Flush()
+
+	case "record":
+		if len(positional) != 2 {
+			return fmt.Errorf("expected a day number or all")
+		}
+
+		days, err := parseDays(*year, positional[1])
+
+		if err != nil {
+			return err
+		}
+
+		for _, day := range days {
+
+			solve, err := common.GetSolver(*year, day)
+
+			if err != nil {
+				return err
+			}
+
+			d, err := common.GetData(*year, day)
+
+			if err != nil {
+				return fmt.Errorf("no data for day %v: %v", day, err)
+			}
+
+			for _, p := range parts {
+
+				ctx, cancel := partContext(*year, day, p, *timeout)
+				a, err := solve(ctx, d, p)
+				cancel()
+
+				if err != nil {
+					return fmt.Errorf("could not solve day %v part %v: %v", day, p, err)
+				}
+
+				if err := g.Set(day, p, a.String()); err != nil {
+					return err
+				}
+
+				log.Printf("Recorded %v Day %v Part %v: %v", *year, day, p, a)
+			}
+		}
+
+	case "verify":
+		s := "all"
+
+		if len(positional) > 1 {
+			s = positional[1]
+		}
+
+		days, err := parseDays(*year, s)
+
+		if err != nil {
+			return err
+		}
+
+		var failed int
+
+		for _, day := range days {
+			for _, p := range parts {
+
+				ctx, cancel := partContext(*year, day, p, *timeout)
+				a, err := common.CheckGolden(ctx, c, g, *year, day, p)
+				cancel()
+
+				switch {
+				case errors.Is(err, common.ErrNoGolden), errors.Is(err, common.ErrNotCached):
+					log.Printf("Skipped %v Day %v Part %v: %v", *year, day, p, err)
+				case err != nil:
+					log.Printf("Failed %v Day %v Part %v: %v", *year, day, p, err)
+					failed++
+				default:
+					log.Printf("Verified %v Day %v Part %v: %v", *year, day, p, a)
+				}
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%v part(s) failed", failed)
+		}
+
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+	_ "github.com/wincus/adventofcode2024/internal/days"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  run <day|all> [--part 1|2|all]   solve the given day(s) using your input,
+                                   cancelling the context of each part
+                                   after --timeout (1m). Use --cpuprofile,
+                                   --memprofile and --trace to profile the
+                                   solvers
+  submit <day> <part> [--answer x] submit the answer for a part
+  golden <command>                 record and verify accepted answers
+  bench <command>                  run and compare benchmarks per commit
+  cache <command>                  manage the cached puzzle inputs
+
+Every command takes --year, defaulting to $AOC_YEAR or the latest
+year with solutions.
+
+Inputs are cached under --cache-dir, $AOC_CACHE_DIR or
+$XDG_CACHE_HOME/adventofcode.
+`
+
+func main() {
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "run":
+		err = run(os.Args[2:])
+	case "submit":
+		err = submit(os.Args[2:])
+	case "golden":
+		err = golden(os.Args[2:])
+	case "bench":
+		err = bench(os.Args[2:])
+	case "cache":
+		err = cache(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseArgs parses flags that may appear before, between or after
+// the positional arguments, returning the positional ones
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// yearFlag registers the --year flag shared by every command. It
+// defaults to the AOC_YEAR env or the latest year with solutions
+func yearFlag(fs *flag.FlagSet) *int {
+
+	year := common.DefaultYear
+
+	if years := common.Years(); len(years) > 0 {
+		year = years[len(years)-1]
+	}
+
+	if y, err := strconv.Atoi(os.Getenv("AOC_YEAR")); err == nil {
+		year = y
+	}
+
+	return fs.Int("year", year, "event year")
+}
+
+// timeoutFlag registers the --timeout flag bounding each part.
+// Parts are only stopped if their solver checks the context
+func timeoutFlag(fs *flag.FlagSet) *time.Duration {
+	return fs.Duration("timeout", time.Minute, "time limit for each part, only enforced by solvers checking the context, 0 for none")
+}
+
+// cacheDirFlag registers the --cache-dir flag shared by every command
+func cacheDirFlag(fs *flag.FlagSet) *string {
+	return fs.String("cache-dir", "", "directory where inputs are cached")
+}
+
+// setCacheDir applies the value of the --cache-dir flag, if given
+func setCacheDir(dir string) {
+	if dir != "" {
+		common.SetCacheDir(dir)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+type profiles struct {
+	cpu, mem, trace string
+}
+
+// profileFlags registers the flags selecting which profiles to write
+func profileFlags(fs *flag.FlagSet) *profiles {
+
+	p := &profiles{}
+
+	fs.StringVar(&p.cpu, "cpuprofile", "", "write a CPU profile to `file`")
+	fs.StringVar(&p.mem, "memprofile", "", "write a heap profile to `file`")
+	fs.StringVar(&p.trace, "trace", "", "write an execution trace to `file`")
+
+	return p
+}
+
+// startProfiling starts the requested CPU profile and trace. The
+// returned function stops them and writes the heap profile
+func startProfiling(p *profiles) (func() error, error) {
+
+	var stops []func() error
+
+	stop := func() error {
+
+		var errs []error
+
+		for _, s := range stops {
+			errs = append(errs, s())
+		}
+
+		return errors.Join(errs...)
+	}
+
+	if p.cpu != "" {
+
+		f, err := os.Create(p.cpu)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create CPU profile: %v", err)
+		}
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("could not start CPU profile: %v", err)
+		}
+
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if p.trace != "" {
+
+		f, err := os.Create(p.trace)
+
+		if err != nil {
+			stop()
+			return nil, fmt.Errorf("could not create trace: %v", err)
+		}
+
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			stop()
+			return nil, fmt.Errorf("could not start trace: %v", err)
+		}
+
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if p.mem != "" {
+		stops = append(stops, func() error {
+
+			f, err := os.Create(p.mem)
+
+			if err != nil {
+				return fmt.Errorf("could not create heap profile: %v", err)
+			}
+
+			defer f.Close()
+
+			// get up-to-date statistics
+			runtime.GC()
+
+			return pprof.Lookup("allocs").WriteTo(f, 0)
+		})
+	}
+
+	return stop, nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+func run(args []string) error {
+
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	part := fs.String("part", "all", "part to solve: 1, 2 or all")
+	timeout := timeoutFlag(fs)
+	prof := profileFlags(fs)
+	year := yearFlag(fs)
+	dir := cacheDirFlag(fs)
+
+	positional, err := parseArgs(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected a day number or all, got %v", positional)
+	}
+
+	setCacheDir(*dir)
+
+	days, err := parseDays(*year, positional[0])
+
+	if err != nil {
+		return err
+	}
+
+	parts, err := parseParts(*part)
+
+	if err != nil {
+		return err
+	}
+
+	// fetch every input first so profiles only cover solving
+	inputs := make(map[int][]string)
+
+	for _, day := range days {
+
+		d, err := common.GetData(*year, day)
+
+		if err != nil {
+			return fmt.Errorf("no data for day %v: %v", day, err)
+		}
+
+		inputs[day] = d
+	}
+
+	stop, err := startProfiling(prof)
+
+	if err != nil {
+		return err
+	}
+
+	var results []result
+	var failed int
+
+	for _, day := range days {
+
+		solve, err := common.GetSolver(*year, day)
+
+		if err != nil {
+			return err
+		}
+
+		for _, p := range parts {
+
+			ctx, cancel := partContext(*year, day, p, *timeout)
+			m := common.Measure(ctx, solve, inputs[day], p)
+			cancel()
+
+			results = append(results, result{day, p, m})
+
+			if m.Err != nil {
+				log.Printf("Failed %v Day %v Part %v: %v", *year, day, p, m.Err)
+				failed++
+				continue
+			}
+
+			log.Printf("Solution for %v Day %v Part %v: %v (%v, %v allocs, %v)", *year, day, p, m.Answer, m.Duration.Round(time.Microsecond), m.Allocs, formatBytes(m.Bytes))
+		}
+	}
+
+	if err := stop(); err != nil {
+		return err
+	}
+
+	if len(days) > 1 {
+		printSummary(results)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%v part(s) failed", failed)
+	}
+
+	return nil
+}
+
+type result struct {
+	day int
+	p   common.Part
+	common.Measurement
+}
+
+// printSummary prints a table with the cost of every part solved
+func printSummary(results []result) {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(w, "DAY\tPART\tTIME\tALLOCS\tBYTES\tANSWER\t")
+
+	var total time.Duration
+
+	for _, r := range results {
+
+		answer := r.Answer.String()
+
+		if r.Err != nil {
+			answer = "error"
+		}
+
+		total += r.Duration
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t\n", r.day, r.p, r.Duration.Round(time.Microsecond), r.Allocs, formatBytes(r.Bytes), answer)
+	}
+
+	fmt.Fprintf(w, "\t\t%v\t\t\t\t\n", total.Round(time.Microsecond))
+
+	w.Flush()
+}
+
+// formatBytes returns n in a human readable unit
+func formatBytes(n uint64) string {
+
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%v B", n)
+	}
+
+	div, exp := uint64(unit), 0
+
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// partContext returns the context a part is solved with, bounded
+// by timeout unless it is zero and logging progress at most once
+// per second
+func partContext(year, day int, p common.Part, timeout time.Duration) (context.Context, context.CancelFunc) {
+
+	ctx := context.Background()
+	cancel := context.CancelFunc(func() {})
+
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	var last time.Time
+
+	ctx = common.WithProgress(ctx, func(done, total int) {
+
+		if time.Since(last) < time.Second {
+			return
+		}
+
+		last = time.Now()
+
+		slog.Info("progress", "year", year, "day", day, "part", p, "done", done, "total", total)
+	})
+
+	return ctx, cancel
+}
+
+// parseDays returns the days of year selected by s, either a
+// single day number or all for every registered day
+func parseDays(year int, s string) ([]int, error) {
+
+	if s == "all" {
+		return common.Days(year), nil
+	}
+
+	n, err := strconv.Atoi(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", s)
+	}
+
+	if _, err := common.GetSolver(year, n); err != nil {
+		return nil, err
+	}
+
+	return []int{n}, nil
+}
+
+// parseParts returns the parts selected by s: 1, 2 or all
+func parseParts(s string) ([]common.Part, error) {
+
+	switch s {
+	case "1":
+		return []common.Part{common.Part1}, nil
+	case "2":
+		return []common.Part{common.Part2}, nil
+	case "all", "":
+		return []common.Part{common.Part1, common.Part2}, nil
+	}
+
+	return nil, fmt.Errorf("invalid part %q", s)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+func submit(args []string) error {
+
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	answer := fs.String("answer", "", "answer to submit instead of the computed one")
+	timeout := timeoutFlag(fs)
+	year := yearFlag(fs)
+	dir := cacheDirFlag(fs)
+
+	positional, err := parseArgs(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 2 {
+		return fmt.Errorf("expected a day and a part, got %v", positional)
+	}
+
+	setCacheDir(*dir)
+
+	day, err := strconv.Atoi(positional[0])
+
+	if err != nil {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+
+	parts, err := parseParts(positional[1])
+
+	if err != nil || len(parts) != 1 {
+		return fmt.Errorf("invalid part %q", positional[1])
+	}
+
+	p := parts[0]
+
+	if *answer == "" {
+
+		solve, err := common.GetSolver(*year, day)
+
+		if err != nil {
+			return err
+		}
+
+		d, err := common.GetData(*year, day)
+
+		if err != nil {
+			return fmt.Errorf("no data for day %v: %v", day, err)
+		}
+
+		ctx, cancel := partContext(*year, day, p, *timeout)
+		a, err := solve(ctx, d, p)
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("could not solve day %v part %v: %v", day, p, err)
+		}
+
+		*answer = a.String()
+	}
+
+	session, err := common.Session()
+
+	if err != nil {
+		return err
+	}
+
+	c, err := common.DefaultCache()
+
+	if err != nil {
+		return err
+	}
+
+	h, err := common.LoadHistory(c.HistoryPath(*year))
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.Check(*year, day, p, *answer); err != nil {
+		return fmt.Errorf("not submitting: %w", err)
+	}
+
+	log.Printf("Submitting %v for %v Day %v Part %v", *answer, *year, day, p)
+
+	r, err := common.NewClient(session).Submit(context.Background(), *year, day, p, *answer)
+
+	if err != nil {
+		return err
+	}
+
+	// waiting means the answer was never checked
+	if r.Outcome != common.Wait {
+		err = h.Record(common.Attempt{
+			Year:    *year,
+			Day:     day,
+			Part:    p,
+			Answer:  *answer,
+			Outcome: r.Outcome,
+			Time:    time.Now(),
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not record attempt: %v", err)
+		}
+	}
+
+	if r.Outcome == common.Correct {
+
+		g, err := common.LoadGolden(c.GoldenPath(*year))
+
+		if err != nil {
+			return err
+		}
+
+		if err := g.Set(day, p, *answer); err != nil {
+			return fmt.Errorf("could not record golden answer: %v", err)
+		}
+	}
+
+	switch r.Outcome {
+	case common.Wait:
+		log.Printf("%v: try again in %v", r.Outcome, r.Wait)
+	case common.Unknown:
+		log.Printf("%v: %v", r.Outcome, r.Message)
+	default:
+		log.Printf("%v", r.Outcome)
+	}
+
+	return nil
+}
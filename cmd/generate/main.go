@@ -3,20 +3,23 @@ package main
 import (
 	"flag"
 	"log"
+	"strconv"
 
 	"github.com/wincus/adventofcode2024/internal/common"
 )
 
 func main() {
 
+	year := flag.String("year", strconv.Itoa(common.DefaultYear), "event year")
 	day := flag.String("day", "", "day number")
+	fetch := flag.Bool("fetch", false, "download the puzzle description and examples")
 	flag.Parse()
 
 	if *day == "" {
 		log.Panicf("day number is required")
 	}
 
-	if err := common.Generate(*day); err != nil {
+	if err := common.Generate(*year, *day, *fetch); err != nil {
 		log.Panic(err)
 	}
 }
@@ -1,7 +1,8 @@
 package day1
 
 import (
-	"log/slog"
+	"context"
+	"fmt"
 	"regexp"
 	"sort"
 	"strconv"
@@ -9,10 +10,18 @@ import (
 	"github.com/wincus/adventofcode2024/internal/common"
 )
 
+func init() {
+	common.Register(2024, 1, Solve)
+}
+
 // Solve returns the solutions for day 1
-func Solve(s []string, p common.Part) int {
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
 
-	l1, l2 := parse(s)
+	l1, l2, err := parse(s)
+
+	if err != nil {
+		return common.Answer{}, err
+	}
 
 	var total int
 
@@ -31,10 +40,10 @@ func Solve(s []string, p common.Part) int {
 		}
 	}
 
-	return total
+	return common.Int(total), nil
 }
 
-func parse(s []string) ([]int, []int) {
+func parse(s []string) ([]int, []int, error) {
 	var l1, l2 []int
 
 	re := regexp.MustCompile(`^(\d+)\s+(\d+)$`)
@@ -48,19 +57,19 @@ func parse(s []string) ([]int, []int) {
 		res := re.FindStringSubmatch(line)
 
 		if len(res) != 3 {
-			slog.Error("Invalid input", "err", "unexpected input", "line", line)
+			return nil, nil, fmt.Errorf("%w: unexpected line %q", common.ErrInvalidInput, line)
 		}
 
 		n1, err := strconv.Atoi(res[1])
 
 		if err != nil {
-			slog.Error("Invalid input", "err", err)
+			return nil, nil, fmt.Errorf("%w: %v", common.ErrInvalidInput, err)
 		}
 
 		n2, err := strconv.Atoi(res[2])
 
 		if err != nil {
-			slog.Error("Invalid input", "err", err)
+			return nil, nil, fmt.Errorf("%w: %v", common.ErrInvalidInput, err)
 		}
 
 		l1 = append(l1, n1)
@@ -70,7 +79,7 @@ func parse(s []string) ([]int, []int) {
 	sort.Ints(l1)
 	sort.Ints(l2)
 
-	return l1, l2
+	return l1, l2, nil
 }
 
 func dis(a, b int) int {
@@ -1,6 +1,8 @@
 package day1
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/wincus/adventofcode2024/internal/common"
@@ -42,10 +44,28 @@ func TestSolver(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		got := Solve(test.input, test.p)
+		got, err := Solve(context.Background(), test.input, test.p)
 
-		if got != test.want {
+		if err != nil {
+			t.Fatalf("unexpected error for part %v: %v", test.p, err)
+		}
+
+		if !got.Equal(common.Int(test.want)) {
 			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
 		}
 	}
 }
+
+func TestSolverInvalidInput(t *testing.T) {
+
+	input := []string{
+		"3   4",
+		"4   x",
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		if _, err := Solve(context.Background(), input, p); !errors.Is(err, common.ErrInvalidInput) {
+			t.Errorf("got %v, want %v for part %v", err, common.ErrInvalidInput, p)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package day2
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,12 +18,20 @@ const (
 
 type level []int
 
+func init() {
+	common.Register(2024, 2, Solve)
+}
+
 // Solve returns the solutions for day 2
-func Solve(s []string, p common.Part) int {
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
 
 	var tolerance int
 
-	levels := parse(s)
+	levels, err := parse(s)
+
+	if err != nil {
+		return common.Answer{}, err
+	}
 
 	switch p {
 	case common.Part1:
@@ -39,11 +49,11 @@ func Solve(s []string, p common.Part) int {
 		}
 	}
 
-	return count
+	return common.Int(count), nil
 
 }
 
-func parse(s []string) []level {
+func parse(s []string) ([]level, error) {
 
 	var levels []level
 
@@ -63,14 +73,14 @@ func parse(s []string) []level {
 			level[i], err = strconv.Atoi(l)
 
 			if err != nil {
-				panic(err)
+				return nil, fmt.Errorf("%w: %v", common.ErrInvalidInput, err)
 			}
 		}
 
 		levels = append(levels, level)
 	}
 
-	return levels
+	return levels, nil
 
 }
 
@@ -1,6 +1,8 @@
 package day2
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -43,9 +45,13 @@ func TestSolver(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		got := Solve(test.input, test.p)
+		got, err := Solve(context.Background(), test.input, test.p)
 
-		if got != test.want {
+		if err != nil {
+			t.Fatalf("unexpected error for part %v: %v", test.p, err)
+		}
+
+		if !got.Equal(common.Int(test.want)) {
 			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
 		}
 	}
@@ -97,3 +103,17 @@ func TestRemoveLevel(t *testing.T) {
 		}
 	}
 }
+
+func TestSolverInvalidInput(t *testing.T) {
+
+	input := []string{
+		"7 6 4 2 1",
+		"1 2 x 4 5",
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		if _, err := Solve(context.Background(), input, p); !errors.Is(err, common.ErrInvalidInput) {
+			t.Errorf("got %v, want %v for part %v", err, common.ErrInvalidInput, p)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package day3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+// BenchmarkSolve solves both parts with your cached input, skipping
+// when it has not been fetched yet
+func BenchmarkSolve(b *testing.B) {
+
+	s, err := common.CachedData(2024, 3)
+
+	if err != nil {
+		b.Skipf("no cached input: %v", err)
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		b.Run("Part"+p.String(), func(b *testing.B) {
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := Solve(context.Background(), s, p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
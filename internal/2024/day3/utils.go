@@ -1,6 +1,7 @@
 package day3
 
 import (
+	"context"
 	"log/slog"
 	"regexp"
 	"strconv"
@@ -27,8 +28,12 @@ type expr struct {
 	b  int
 }
 
+func init() {
+	common.Register(2024, 3, Solve)
+}
+
 // Solve returns the solutions for day 3
-func Solve(s []string, p common.Part) int {
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
 
 	var total int
 
@@ -64,7 +69,7 @@ func Solve(s []string, p common.Part) int {
 		}
 	}
 
-	return total
+	return common.Int(total), nil
 }
 
 func parse(s string) instructions {
@@ -0,0 +1,57 @@
+package day4
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+// BenchmarkSolve solves both parts with your cached input, skipping
+// when it has not been fetched yet
+func BenchmarkSolve(b *testing.B) {
+
+	s, err := common.CachedData(2024, 4)
+
+	if err != nil {
+		b.Skipf("no cached input: %v", err)
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		b.Run("Part"+p.String(), func(b *testing.B) {
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := Solve(context.Background(), s, p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkCountXMAS counts the words starting at every cell of a
+// puzzle sized grid, which is dominated by visiting neighbours
+func BenchmarkCountXMAS(b *testing.B) {
+
+	s := make([]string, 140)
+
+	for y := range s {
+		s[y] = strings.Repeat("XMASAMX"[y%7:]+"XMASAMX"[:y%7], 20)
+	}
+
+	board := common.ParseRune(s)
+	d := board.GetDimension()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for y := 0; y < d.N; y++ {
+			for x := 0; x < d.M; x++ {
+				countXMAS(&board, common.Position{X: x, Y: y})
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package day4
+
+import (
+	"context"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+const XMAS = "XMAS"
+
+func init() {
+	common.Register(2024, 4, Solve)
+}
+
+// Solve returns the solutions for day 4
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
+
+	b := common.ParseRune(s)
+
+	var total int
+
+	for pos := range b.All() {
+
+		if p == common.Part1 {
+			total += countXMAS(&b, pos)
+		}
+
+		if p == common.Part2 {
+			if isMAS(&b, pos) {
+				total++
+			}
+		}
+	}
+
+	return common.Int(total), nil
+
+}
+
+// countXMAS returns how many words start at position, reading in
+// any of the 8 directions
+func countXMAS(board *common.Board[rune], position common.Position) int {
+
+	var count int
+
+	for _, direction := range common.Directions8() {
+		if common.MatchRay(board, position, direction, XMAS) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isMAS reports whether position is the center of two crossed MAS,
+// each of them read either way
+func isMAS(board *common.Board[rune], position common.Position) bool {
+
+	for _, direction := range []common.Direction{common.Downright, common.Downleft} {
+
+		start := position.Add(direction.Opposite().Delta())
+
+		if !common.MatchRay(board, start, direction, "MAS") && !common.MatchRay(board, start, direction, "SAM") {
+			return false
+		}
+	}
+
+	return true
+}
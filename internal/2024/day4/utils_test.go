@@ -1,6 +1,7 @@
 package day4
 
 import (
+	"context"
 	"testing"
 
 	"github.com/wincus/adventofcode2024/internal/common"
@@ -77,9 +78,13 @@ func TestSolver(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		got := Solve(test.input, test.p)
+		got, err := Solve(context.Background(), test.input, test.p)
 
-		if got != test.want {
+		if err != nil {
+			t.Fatalf("unexpected error for part %v: %v", test.p, err)
+		}
+
+		if !got.Equal(common.Int(test.want)) {
 			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
 		}
 	}
@@ -1,7 +1,8 @@
 package day5
 
 import (
-	"log/slog"
+	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,13 +17,26 @@ type Update struct {
 	pages []int
 }
 
+func init() {
+	common.Register(2024, 5, Solve)
+}
+
 // Solve returns the solutions for day 5
-func Solve(s []string, p common.Part) int {
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
 
 	rules, updates := split(s)
 
-	r := parseRules(rules)
-	u := parseUpdates(updates)
+	r, err := parseRules(rules)
+
+	if err != nil {
+		return common.Answer{}, err
+	}
+
+	u, err := parseUpdates(updates)
+
+	if err != nil {
+		return common.Answer{}, err
+	}
 
 	var orderedUpdates []Update
 	var outOfOrder []Update
@@ -42,30 +56,43 @@ func Solve(s []string, p common.Part) int {
 			total += getMiddle(update.pages)
 		}
 
-		return total
+		return common.Int(total), nil
 	}
 
 	if p == common.Part2 {
 
-		for _, update := range fixAll(r, outOfOrder) {
+		fixed, err := fixAll(ctx, r, outOfOrder)
+
+		if err != nil {
+			return common.Answer{}, err
+		}
+
+		for _, update := range fixed {
 			total += getMiddle(update.pages)
 		}
 
-		return total
+		return common.Int(total), nil
 	}
 
-	return 0
+	return common.Int(0), nil
 }
 
-func fixAll(rules []Rule, updates []Update) []Update {
+func fixAll(ctx context.Context, rules []Rule, updates []Update) ([]Update, error) {
 
 	var fixed []Update
 
-	for _, update := range updates {
+	for i, update := range updates {
+
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("stopped after fixing %v of %v updates: %w", i, len(updates), ctx.Err())
+		}
+
+		common.ReportProgress(ctx, i, len(updates))
+
 		fixed = append(fixed, fixUpdate(rules, update))
 	}
 
-	return fixed
+	return fixed, nil
 }
 
 func fixUpdate(rules []Rule, update Update) Update {
@@ -156,40 +183,41 @@ func split(s []string) ([]string, []string) {
 	return prefix, suffix
 }
 
-func parseRules(s []string) []Rule {
+func parseRules(s []string) ([]Rule, error) {
 
 	var rules []Rule
 
 	for _, line := range s {
 
+		if len(line) == 0 {
+			continue
+		}
+
 		rule := strings.Split(line, "|")
 
 		if len(rule) != 2 {
-			slog.Error("Invalid rule", "rule", rule)
-			continue
+			return nil, fmt.Errorf("%w: rule %q", common.ErrInvalidInput, line)
 		}
 
 		a, err := strconv.Atoi(rule[0])
 
 		if err != nil {
-			slog.Error("Invalid rule", "rule", rule)
-			continue
+			return nil, fmt.Errorf("%w: rule %q", common.ErrInvalidInput, line)
 		}
 
 		b, err := strconv.Atoi(rule[1])
 
 		if err != nil {
-			slog.Error("Invalid rule", "rule", rule)
-			continue
+			return nil, fmt.Errorf("%w: rule %q", common.ErrInvalidInput, line)
 		}
 
 		rules = append(rules, Rule{a, b})
 	}
 
-	return rules
+	return rules, nil
 }
 
-func parseUpdates(s []string) []Update {
+func parseUpdates(s []string) ([]Update, error) {
 
 	var updates []Update
 
@@ -198,8 +226,7 @@ func parseUpdates(s []string) []Update {
 		update := strings.Split(line, ",")
 
 		if len(update) < 2 {
-			slog.Error("Invalid update", "update", update)
-			continue
+			return nil, fmt.Errorf("%w: update %q", common.ErrInvalidInput, line)
 		}
 
 		var pages []int
@@ -208,8 +235,7 @@ func parseUpdates(s []string) []Update {
 			page, err := strconv.Atoi(p)
 
 			if err != nil {
-				slog.Error("Invalid page", "page", p)
-				continue
+				return nil, fmt.Errorf("%w: page %q", common.ErrInvalidInput, p)
 			}
 
 			pages = append(pages, page)
@@ -218,5 +244,5 @@ func parseUpdates(s []string) []Update {
 		updates = append(updates, Update{pages})
 	}
 
-	return updates
+	return updates, nil
 }
@@ -0,0 +1,61 @@
+package day6
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+// BenchmarkSolve solves both parts with your cached input, skipping
+// when it has not been fetched yet
+func BenchmarkSolve(b *testing.B) {
+
+	s, err := common.CachedData(2024, 6)
+
+	if err != nil {
+		b.Skipf("no cached input: %v", err)
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		b.Run("Part"+p.String(), func(b *testing.B) {
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := Solve(context.Background(), s, p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkWalk walks the guard across a puzzle sized empty map,
+// which is dominated by moving from cell to cell
+func BenchmarkWalk(b *testing.B) {
+
+	s := make([]string, 130)
+
+	for y := range s {
+		s[y] = strings.Repeat(".", 130)
+	}
+
+	s[129] = strings.Repeat(".", 65) + "^" + strings.Repeat(".", 64)
+
+	board := common.ParseRune(s)
+	g := findGuard(board)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+
+		board.ResetVisits()
+		board.ResetPaths()
+
+		if _, err := walk(context.Background(), board, g); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package day6
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+func init() {
+	common.Register(2024, 6, Solve)
+}
+
+// Solve returns the solutions for day 6
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
+
+	b := common.ParseRune(s)
+
+	g := findGuard(b)
+
+	if g.Direction == common.Unspecified {
+		return common.Answer{}, fmt.Errorf("%w: guard not found", common.ErrInvalidInput)
+	}
+
+	if p == common.Part1 {
+
+		loop, err := walk(ctx, b, g)
+
+		if err != nil {
+			return common.Answer{}, err
+		}
+
+		if loop {
+			return common.Answer{}, fmt.Errorf("%w: the guard never leaves", common.ErrInvalidInput)
+		}
+
+		return common.Int(len(b.GetVisited())), nil
+	}
+
+	if p == common.Part2 {
+
+		var total int
+
+		n := b.GetDimension().N
+
+		for pos, v := range b.All() {
+
+			if pos.X == 0 {
+				common.ReportProgress(ctx, pos.Y, n)
+			}
+
+			// skip positions with obstacles and the guard position
+			if v == '#' || pos == g.Position {
+				continue
+			}
+
+			loop, err := walk(ctx, b.WithOverrides(map[common.Position]rune{pos: 'O'}), g)
+
+			if err != nil {
+				return common.Answer{}, fmt.Errorf("stopped at row %v of %v with %v loops found: %w", pos.Y, n, total, err)
+			}
+
+			if loop {
+				total++
+			}
+		}
+
+		common.ReportProgress(ctx, n, n)
+
+		return common.Int(total), nil
+	}
+
+	return common.Int(0), nil
+
+}
+
+// guards maps the marks of the guard to where it is facing
+var guards = map[rune]common.Direction{
+	'^': common.Up,
+	'v': common.Down,
+	'>': common.Right,
+	'<': common.Left,
+}
+
+func findGuard(b common.Board[rune]) common.PositionWithDirection {
+
+	for p, v := range b.All() {
+		if d, ok := guards[v]; ok {
+			return common.PositionWithDirection{
+				Position:  p,
+				Direction: d,
+			}
+		}
+	}
+
+	return common.PositionWithDirection{}
+}
+
+// walk the board, returns true if the path is a loop
+func walk(ctx context.Context, b common.Board[rune], g common.PositionWithDirection) (bool, error) {
+
+	blocked := func(_ common.Position, v rune) bool {
+		return v == '#' || v == 'O'
+	}
+
+	// visit reports whether the guard has already been here facing
+	// the same direction, which means we are in a loop
+	visit := func() bool {
+		b.VisitPath(g)
+		return b.GetPaths(g) > 1
+	}
+
+	if _, err := b.Get(g.Position); err != nil {
+		return false, nil
+	}
+
+	visit()
+
+	for {
+
+		// checking once per straight line, every step is too costly
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		for p := range b.Cast(g.Position, g.Direction, blocked) {
+
+			g.Position = p
+
+			if visit() {
+				return true, nil
+			}
+		}
+
+		// nothing stopped the guard but the edge of the board
+		if _, ok := b.Move(g.Position, g.Direction); !ok {
+			return false, nil
+		}
+
+		g.Direction = g.Direction.TurnRight()
+
+		if visit() {
+			return true, nil
+		}
+	}
+}
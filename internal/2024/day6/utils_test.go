@@ -0,0 +1,117 @@
+package day6
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+type Test struct {
+	input []string
+	p     common.Part
+	want  int
+}
+
+func TestSolver(t *testing.T) {
+
+	tests := []Test{
+		{
+			input: []string{
+				"....#.....",
+				".........#",
+				"..........",
+				"..#.......",
+				".......#..",
+				"..........",
+				".#..^.....",
+				"........#.",
+				"#.........",
+				"......#...",
+			},
+			p:    common.Part1,
+			want: 41,
+		},
+		{
+			input: []string{
+				"....#.....",
+				".........#",
+				"..........",
+				"..#.......",
+				".......#..",
+				"..........",
+				".#..^.....",
+				"........#.",
+				"#.........",
+				"......#...",
+			}, p: common.Part2,
+			want: 6,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := Solve(context.Background(), test.input, test.p)
+
+		if err != nil {
+			t.Fatalf("unexpected error for part %v: %v", test.p, err)
+		}
+
+		if !got.Equal(common.Int(test.want)) {
+			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
+		}
+	}
+}
+
+func TestSolverInvalidInput(t *testing.T) {
+
+	input := []string{
+		"....#.....",
+		"..........",
+	}
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		if _, err := Solve(context.Background(), input, p); !errors.Is(err, common.ErrInvalidInput) {
+			t.Errorf("got %v, want %v for part %v", err, common.ErrInvalidInput, p)
+		}
+	}
+}
+
+func TestSolverCancelled(t *testing.T) {
+
+	input := []string{
+		"....#.....",
+		".........#",
+		"..........",
+		"..#.......",
+		".......#..",
+		"..........",
+		".#..^.....",
+		"........#.",
+		"#.........",
+		"......#...",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, p := range []common.Part{common.Part1, common.Part2} {
+		if _, err := Solve(ctx, input, p); !errors.Is(err, context.Canceled) {
+			t.Errorf("got %v, want %v for part %v", err, context.Canceled, p)
+		}
+	}
+
+	var done, total int
+
+	ctx = common.WithProgress(context.Background(), func(d, t int) {
+		done, total = d, t
+	})
+
+	if _, err := Solve(ctx, input, common.Part2); err != nil {
+		t.Fatal(err)
+	}
+
+	if done != 10 || total != 10 {
+		t.Errorf("got progress %v/%v, want 10/10", done, total)
+	}
+}
@@ -0,0 +1,319 @@
+package common
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"sort"
+	"strings"
+)
+
+var (
+	// Errors
+	ErrTooManyColors = errors.New("animation needs more than 256 colors")
+)
+
+// AnimationOptions configures how the steps recorded on a board are
+// exported. Zero values fall back to the defaults noted
+type AnimationOptions[T comparable] struct {
+	CellSize      int               // pixels per side of a cell, 4
+	Palette       map[T]color.Color // color of the cells by value
+	Background    color.Color       // color of values missing in Palette, white
+	Trail         color.Color       // color of visited cells, yellow
+	Current       color.Color       // color of the current position, red
+	FPS           int               // frames per second, 25
+	StepsPerFrame int               // steps drawn by each frame, 1
+}
+
+// EncodeGIF writes the steps recorded on b as an animated GIF. The
+// first frame shows the board alone, then each frame adds the next
+// steps. Frames only cover the cells that changed, so long walks on
+// large boards stay small
+func EncodeGIF[T comparable](w io.Writer, b *Board[T], o AnimationOptions[T]) error {
+
+	o = o.withDefaults()
+
+	palette, err := o.palette()
+
+	if err != nil {
+		return err
+	}
+
+	d := b.GetDimension()
+
+	a := animation[T]{b: b, o: o, trail: make(map[Position]bool)}
+
+	g := &gif.GIF{
+		Config: image.Config{
+			ColorModel: palette,
+			Width:      d.M * o.CellSize,
+			Height:     d.N * o.CellSize,
+		},
+	}
+
+	addFrame := func(r Rect) {
+
+		s := o.CellSize
+
+		img := image.NewPaletted(image.Rect(r.Min.X*s, r.Min.Y*s, (r.Max.X+1)*s, (r.Max.Y+1)*s), palette)
+
+		for y := r.Min.Y; y <= r.Max.Y; y++ {
+			for x := r.Min.X; x <= r.Max.X; x++ {
+
+				i := uint8(palette.Index(a.color(Position{X: x, Y: y})))
+
+				for py := y * s; py < (y+1)*s; py++ {
+					for px := x * s; px < (x+1)*s; px++ {
+						img.SetColorIndex(px, py, i)
+					}
+				}
+			}
+		}
+
+		g.Image = append(g.Image, img)
+		g.Delay = append(g.Delay, max(2, 100/o.FPS))
+		g.Disposal = append(g.Disposal, gif.DisposalNone)
+	}
+
+	addFrame(Rect{Max: Position{X: d.M - 1, Y: d.N - 1}})
+
+	for a.next() {
+		addFrame(a.changed())
+	}
+
+	return gif.EncodeAll(w, g)
+}
+
+// EncodeSVG writes the steps recorded on b as an animated SVG,
+// timed the same way as EncodeGIF
+func EncodeSVG[T comparable](w io.Writer, b *Board[T], o AnimationOptions[T]) error {
+
+	o = o.withDefaults()
+
+	d := b.GetDimension()
+	s := o.CellSize
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%v\" height=\"%v\" viewBox=\"0 0 %[1]v %[2]v\">\n", d.M*s, d.N*s)
+	fmt.Fprintf(bw, "<rect width=\"%v\" height=\"%v\" fill=\"%v\"/>\n", d.M*s, d.N*s, hexColor(o.Background))
+
+	for y := 0; y < d.N; y++ {
+		for x := 0; x < d.M; x++ {
+
+			v, _ := b.Get(Position{X: x, Y: y})
+
+			if c, ok := o.Palette[v]; ok {
+				fmt.Fprintf(bw, "<rect x=\"%v\" y=\"%v\" width=\"%v\" height=\"%[3]v\" fill=\"%v\"/>\n", x*s, y*s, s, hexColor(c))
+			}
+		}
+	}
+
+	a := animation[T]{b: b, o: o, trail: make(map[Position]bool)}
+
+	var xs, ys []string
+
+	for frame := 1; a.next(); frame++ {
+
+		begin := float64(frame) / float64(o.FPS)
+
+		for _, p := range a.added {
+			fmt.Fprintf(bw, "<rect x=\"%v\" y=\"%v\" width=\"%v\" height=\"%[3]v\" fill=\"%v\" visibility=\"hidden\">", p.X*s, p.Y*s, s, hexColor(o.Trail))
+			fmt.Fprintf(bw, "<set attributeName=\"visibility\" to=\"visible\" begin=\"%.3fs\" fill=\"freeze\"/></rect>\n", begin)
+		}
+
+		xs = append(xs, fmt.Sprint(a.cur.X*s))
+		ys = append(ys, fmt.Sprint(a.cur.Y*s))
+	}
+
+	if len(xs) > 0 {
+
+		begin := 1 / float64(o.FPS)
+		dur := float64(len(xs)) / float64(o.FPS)
+
+		fmt.Fprintf(bw, "<rect width=\"%v\" height=\"%[1]v\" fill=\"%v\" visibility=\"hidden\">\n", s, hexColor(o.Current))
+		fmt.Fprintf(bw, "<set attributeName=\"visibility\" to=\"visible\" begin=\"%.3fs\" fill=\"freeze\"/>\n", begin)
+
+		for _, attr := range []struct {
+			name   string
+			values []string
+		}{{"x", xs}, {"y", ys}} {
+			fmt.Fprintf(bw, "<animate attributeName=\"%v\" values=\"%v\" begin=\"%.3fs\" dur=\"%.3fs\" calcMode=\"discrete\" fill=\"freeze\"/>\n", attr.name, strings.Join(attr.values, ";"), begin, dur)
+		}
+
+		bw.WriteString("</rect>\n")
+	}
+
+	bw.WriteString("</svg>\n")
+
+	return bw.Flush()
+}
+
+// animation replays the recorded steps of a board frame by frame
+type animation[T comparable] struct {
+	b     *Board[T]
+	o     AnimationOptions[T]
+	step  int
+	trail map[Position]bool
+	cur   *Position
+	prev  *Position
+	added []Position // cells visited for the first time in this frame
+	moved []Position // cells visited in this frame
+}
+
+// next advances to the following frame, reporting false once every
+// step has been shown
+func (a *animation[T]) next() bool {
+
+	steps := a.b.Steps()
+
+	if a.step >= len(steps) {
+		return false
+	}
+
+	a.prev = a.cur
+	a.added = a.added[:0]
+	a.moved = a.moved[:0]
+
+	end := min(a.step+a.o.StepsPerFrame, len(steps))
+
+	for _, s := range steps[a.step:end] {
+
+		if !a.trail[s.Position] {
+			a.trail[s.Position] = true
+			a.added = append(a.added, s.Position)
+		}
+
+		a.moved = append(a.moved, s.Position)
+	}
+
+	a.step = end
+
+	cur := steps[end-1].Position
+	a.cur = &cur
+
+	return true
+}
+
+// changed returns the smallest rectangle covering the cells that
+// changed color in the current frame
+func (a *animation[T]) changed() Rect {
+
+	cells := append([]Position(nil), a.moved...)
+
+	if a.prev != nil {
+		cells = append(cells, *a.prev)
+	}
+
+	return NewRegion(cells).Bounds()
+}
+
+// color returns the color of p in the current frame
+func (a *animation[T]) color(p Position) color.Color {
+
+	if a.cur != nil && p == *a.cur {
+		return a.o.Current
+	}
+
+	if a.trail[p] {
+		return a.o.Trail
+	}
+
+	v, _ := a.b.Get(p)
+
+	if c, ok := a.o.Palette[v]; ok {
+		return c
+	}
+
+	return a.o.Background
+}
+
+func (o AnimationOptions[T]) withDefaults() AnimationOptions[T] {
+
+	if o.CellSize <= 0 {
+		o.CellSize = 4
+	}
+
+	if o.Background == nil {
+		o.Background = color.White
+	}
+
+	if o.Trail == nil {
+		o.Trail = color.RGBA{R: 0xff, G: 0xd7, A: 0xff}
+	}
+
+	if o.Current == nil {
+		o.Current = color.RGBA{R: 0xff, A: 0xff}
+	}
+
+	if o.FPS <= 0 {
+		o.FPS = 25
+	}
+
+	if o.StepsPerFrame <= 0 {
+		o.StepsPerFrame = 1
+	}
+
+	return o
+}
+
+// palette returns every color used, sorted so the output does not
+// depend on map order
+func (o AnimationOptions[T]) palette() (color.Palette, error) {
+
+	seen := make(map[color.RGBA64]bool)
+
+	var p color.Palette
+
+	add := func(c color.Color) {
+
+		k := color.RGBA64Model.Convert(c).(color.RGBA64)
+
+		if !seen[k] {
+			seen[k] = true
+			p = append(p, k)
+		}
+	}
+
+	add(o.Background)
+	add(o.Trail)
+	add(o.Current)
+
+	for _, c := range o.Palette {
+		add(c)
+	}
+
+	if len(p) > 256 {
+		return nil, ErrTooManyColors
+	}
+
+	sort.Slice(p, func(i, j int) bool {
+
+		a, b := p[i].(color.RGBA64), p[j].(color.RGBA64)
+
+		if a.R != b.R {
+			return a.R < b.R
+		}
+
+		if a.G != b.G {
+			return a.G < b.G
+		}
+
+		if a.B != b.B {
+			return a.B < b.B
+		}
+
+		return a.A < b.A
+	})
+
+	return p, nil
+}
+
+func hexColor(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"strings"
+	"testing"
+)
+
+func walkedBoard() Board[rune] {
+
+	b := ParseRune([]string{
+		"#...",
+		"....",
+		"....",
+	})
+
+	b.Record()
+
+	for x := 0; x < 4; x++ {
+		b.VisitPath(PositionWithDirection{Position{X: x, Y: 2}, Right})
+	}
+
+	return b
+}
+
+func TestRecord(t *testing.T) {
+
+	b := ParseRune([]string{"..."})
+
+	b.VisitPath(PositionWithDirection{Position{X: 0, Y: 0}, Right})
+
+	if got := len(b.Steps()); got != 0 {
+		t.Errorf("got %v steps before recording, want 0", got)
+	}
+
+	b.Record()
+
+	// walks usually take the board by value
+	c := b
+
+	c.VisitPath(PositionWithDirection{Position{X: 1, Y: 0}, Right})
+	c.VisitPath(PositionWithDirection{Position{X: 2, Y: 0}, Right})
+
+	want := []PositionWithDirection{{Position{X: 1, Y: 0}, Right}, {Position{X: 2, Y: 0}, Right}}
+
+	got := b.Steps()
+
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEncodeGIF(t *testing.T) {
+
+	b := walkedBoard()
+
+	wall := color.RGBA{B: 0xff, A: 0xff}
+
+	o := AnimationOptions[rune]{
+		CellSize:      2,
+		Palette:       map[rune]color.Color{'#': wall},
+		StepsPerFrame: 2,
+		FPS:           10,
+	}
+
+	var buf bytes.Buffer
+
+	if err := EncodeGIF(&buf, &b, o); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := gif.DecodeAll(&buf)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(g.Image); got != 3 {
+		t.Fatalf("got %v frames, want %v", got, 3)
+	}
+
+	if got := g.Delay[0]; got != 10 {
+		t.Errorf("got delay %v, want %v", got, 10)
+	}
+
+	if got := g.Image[0].Bounds().Dx(); got != 8 {
+		t.Errorf("got width %v, want %v", got, 8)
+	}
+
+	type test struct {
+		frame int
+		x, y  int
+		want  color.Color
+	}
+
+	tests := []test{
+		{frame: 0, x: 0, y: 0, want: wall},
+		{frame: 0, x: 2, y: 0, want: color.White},
+		{frame: 1, x: 0, y: 4, want: color.RGBA{R: 0xff, G: 0xd7, A: 0xff}},
+		{frame: 1, x: 2, y: 4, want: color.RGBA{R: 0xff, A: 0xff}},
+		{frame: 2, x: 6, y: 4, want: color.RGBA{R: 0xff, A: 0xff}},
+	}
+
+	for _, test := range tests {
+
+		r, g1, b1, a := g.Image[test.frame].At(test.x, test.y).RGBA()
+		wr, wg, wb, wa := test.want.RGBA()
+
+		if r != wr || g1 != wg || b1 != wb || a != wa {
+			t.Errorf("got %v, want %v at %v,%v of frame %v", g.Image[test.frame].At(test.x, test.y), test.want, test.x, test.y, test.frame)
+		}
+	}
+}
+
+func TestEncodeSVG(t *testing.T) {
+
+	b := walkedBoard()
+
+	var buf bytes.Buffer
+
+	if err := EncodeSVG(&buf, &b, AnimationOptions[rune]{Palette: map[rune]color.Color{'#': color.Black}}); err != nil {
+		t.Fatal(err)
+	}
+
+	svg := buf.String()
+
+	type test struct {
+		s    string
+		want int
+	}
+
+	tests := []test{
+		{s: "<svg ", want: 1},
+		{s: `fill="#000000"`, want: 1},
+		{s: `to="visible"`, want: 5},
+		{s: `values="0;4;8;12"`, want: 1},
+		{s: `values="8;8;8;8"`, want: 1},
+	}
+
+	for _, test := range tests {
+		if got := strings.Count(svg, test.s); got != test.want {
+			t.Errorf("got %v, want %v occurrences of %v", got, test.want, test.s)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+type answerKind int
+
+const (
+	intAnswer answerKind = iota
+	bigAnswer
+	textAnswer
+)
+
+var (
+	// Errors
+	ErrInvalidInput = errors.New("invalid input")
+)
+
+// Answer is the solution to a part of a puzzle. It holds an int64,
+// a big integer or a string, and is submitted in its String form
+type Answer struct {
+	kind answerKind
+	n    int64
+	b    *big.Int
+	s    string
+}
+
+// Int returns an answer holding n
+func Int(n int) Answer {
+	return Answer{kind: intAnswer, n: int64(n)}
+}
+
+// Int64 returns an answer holding n
+func Int64(n int64) Answer {
+	return Answer{kind: intAnswer, n: n}
+}
+
+// BigInt returns an answer holding a copy of n
+func BigInt(n *big.Int) Answer {
+	return Answer{kind: bigAnswer, b: new(big.Int).Set(n)}
+}
+
+// Text returns an answer holding s, for puzzles whose solution
+// is not a number
+func Text(s string) Answer {
+	return Answer{kind: textAnswer, s: s}
+}
+
+func (a Answer) String() string {
+
+	switch a.kind {
+	case bigAnswer:
+		return a.b.String()
+	case textAnswer:
+		return a.s
+	}
+
+	return strconv.FormatInt(a.n, 10)
+}
+
+// Equal reports whether both answers would be submitted as the
+// same value, so Int(5) equals BigInt(big.NewInt(5))
+func (a Answer) Equal(b Answer) bool {
+	return a.String() == b.String()
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAnswer(t *testing.T) {
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	type test struct {
+		a    Answer
+		want string
+	}
+
+	tests := []test{
+		{Int(42), "42"},
+		{Int64(-9000000000000000000), "-9000000000000000000"},
+		{BigInt(huge), "123456789012345678901234567890"},
+		{Text("ABCDEF"), "ABCDEF"},
+		{Answer{}, "0"},
+	}
+
+	for _, test := range tests {
+		if got := test.a.String(); got != test.want {
+			t.Errorf("got %v, want %v", got, test.want)
+		}
+	}
+
+	if !Int(5).Equal(BigInt(big.NewInt(5))) {
+		t.Errorf("Int(5) should equal BigInt(5)")
+	}
+
+	if Int(5).Equal(Text("five")) {
+		t.Errorf("Int(5) should not equal Text(five)")
+	}
+}
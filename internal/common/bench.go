@@ -0,0 +1,180 @@
+package common
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var reProcs = regexp.MustCompile(`-\d+$`)
+
+// Unit is a measurement reported by go test -bench
+type Unit string
+
+const (
+	NsPerOp     Unit = "ns/op"
+	BytesPerOp  Unit = "B/op"
+	AllocsPerOp Unit = "allocs/op"
+)
+
+// Units lists the measurements compared between benchmark runs
+var Units = []Unit{NsPerOp, BytesPerOp, AllocsPerOp}
+
+// BenchResults holds the samples of every benchmark of a run,
+// keyed by package and name without the GOMAXPROCS suffix
+type BenchResults map[string]map[Unit][]float64
+
+// Delta compares a benchmark between two runs
+type Delta struct {
+	Name     string
+	Unit     Unit
+	Old, New float64 // means
+	OldVar   float64 // spread around the mean, as a fraction
+	NewVar   float64
+	Change   float64 // relative change, as a fraction, +Inf from 0
+}
+
+// BenchPath returns where the results of a benchmark run for
+// year at the given commit are stored
+func (c *Cache) BenchPath(year int, commit string) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), c.Account, "bench", commit+".txt")
+}
+
+// ParseBench reads the output of go test -bench
+func ParseBench(r io.Reader) (BenchResults, error) {
+
+	results := make(BenchResults)
+
+	var pkg string
+
+	s := bufio.NewScanner(r)
+
+	for s.Scan() {
+
+		line := s.Text()
+
+		if p, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = strings.TrimSpace(p)
+			continue
+		}
+
+		f := strings.Fields(line)
+
+		if len(f) < 4 || !strings.HasPrefix(f[0], "Benchmark") {
+			continue
+		}
+
+		// skip the iteration count
+		if _, err := strconv.Atoi(f[1]); err != nil {
+			continue
+		}
+
+		name := reProcs.ReplaceAllString(f[0], "")
+
+		if pkg != "" {
+			name = pkg + "." + name
+		}
+
+		for i := 2; i+1 < len(f); i += 2 {
+
+			v, err := strconv.ParseFloat(f[i], 64)
+
+			if err != nil {
+				continue
+			}
+
+			if results[name] == nil {
+				results[name] = make(map[Unit][]float64)
+			}
+
+			u := Unit(f[i+1])
+			results[name][u] = append(results[name][u], v)
+		}
+	}
+
+	return results, s.Err()
+}
+
+// CompareBench returns the change of every unit of the benchmarks
+// present in both runs, sorted by name
+func CompareBench(before, after BenchResults) []Delta {
+
+	var names []string
+
+	for name := range after {
+		if _, ok := before[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var deltas []Delta
+
+	for _, name := range names {
+		for _, u := range Units {
+
+			o, n := before[name][u], after[name][u]
+
+			if len(o) == 0 || len(n) == 0 {
+				continue
+			}
+
+			d := Delta{
+				Name: name,
+				Unit: u,
+			}
+
+			d.Old, d.OldVar = meanSpread(o)
+			d.New, d.NewVar = meanSpread(n)
+
+			switch {
+			case d.Old != 0:
+				d.Change = (d.New - d.Old) / d.Old
+			case d.New != 0:
+				// any growth from nothing, such as allocating again
+				d.Change = math.Inf(1)
+			}
+
+			deltas = append(deltas, d)
+		}
+	}
+
+	return deltas
+}
+
+// Significant reports whether the change is larger than the noise
+// of either run
+func (d Delta) Significant() bool {
+	return math.Abs(d.Change) > math.Max(d.OldVar, d.NewVar)
+}
+
+// meanSpread returns the mean of the samples and the largest
+// distance of a sample to it, relative to the mean
+func meanSpread(samples []float64) (float64, float64) {
+
+	var sum float64
+
+	for _, v := range samples {
+		sum += v
+	}
+
+	mean := sum / float64(len(samples))
+
+	if mean == 0 {
+		return 0, 0
+	}
+
+	var spread float64
+
+	for _, v := range samples {
+		spread = math.Max(spread, math.Abs(v-mean)/mean)
+	}
+
+	return mean, spread
+}
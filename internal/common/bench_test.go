@@ -0,0 +1,135 @@
+package common
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+const benchOld = `goos: linux
+goarch: amd64
+pkg: github.com/wincus/adventofcode2024/internal/2024/day6
+cpu: AMD EPYC
+BenchmarkSolve/Part1-8         	    1000	     10000 ns/op	    2000 B/op	      20 allocs/op
+BenchmarkSolve/Part1-8         	    1000	     12000 ns/op	    2000 B/op	      20 allocs/op
+BenchmarkSolve/Part2-8         	      10	   1000000 ns/op	  500000 B/op	    9000 allocs/op
+PASS
+ok  	github.com/wincus/adventofcode2024/internal/2024/day6	3.001s
+`
+
+const benchNew = `pkg: github.com/wincus/adventofcode2024/internal/2024/day6
+BenchmarkSolve/Part1-8         	    1000	     11500 ns/op	    2000 B/op	      20 allocs/op
+BenchmarkSolve/Part1-8         	    1000	     10500 ns/op	    2000 B/op	      20 allocs/op
+BenchmarkSolve/Part2-8         	      20	    500000 ns/op	  100000 B/op	    1000 allocs/op
+pkg: github.com/wincus/adventofcode2024/internal/2024/day4
+BenchmarkSolve/Part1-8         	     100	     50000 ns/op
+`
+
+func TestParseBench(t *testing.T) {
+
+	r, err := ParseBench(strings.NewReader(benchOld))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := r["github.com/wincus/adventofcode2024/internal/2024/day6.BenchmarkSolve/Part1"][NsPerOp]
+
+	if len(got) != 2 || got[0] != 10000 || got[1] != 12000 {
+		t.Errorf("got %v, want [10000 12000]", got)
+	}
+
+	if len(r) != 2 {
+		t.Errorf("got %v benchmarks, want 2", len(r))
+	}
+}
+
+func TestCompareBench(t *testing.T) {
+
+	before, err := ParseBench(strings.NewReader(benchOld))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := ParseBench(strings.NewReader(benchNew))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deltas := CompareBench(before, after)
+
+	// day4 only exists in the new run
+	if len(deltas) != 6 {
+		t.Fatalf("got %v deltas, want 6", len(deltas))
+	}
+
+	type test struct {
+		change      float64
+		significant bool
+	}
+
+	tests := []test{
+		{0, false},       // Part1 ns/op: same mean, within noise
+		{0, false},       // Part1 B/op
+		{0, false},       // Part1 allocs/op
+		{-0.5, true},     // Part2 ns/op
+		{-0.8, true},     // Part2 B/op
+		{-8.0 / 9, true}, // Part2 allocs/op
+	}
+
+	for i, test := range tests {
+
+		d := deltas[i]
+
+		if math.Abs(d.Change-test.change) > 1e-9 {
+			t.Errorf("%v %v: got change %v, want %v", d.Name, d.Unit, d.Change, test.change)
+		}
+
+		if d.Significant() != test.significant {
+			t.Errorf("%v %v: got significant %v, want %v", d.Name, d.Unit, d.Significant(), test.significant)
+		}
+	}
+}
+
+func TestCompareBenchFromZero(t *testing.T) {
+
+	before, err := ParseBench(strings.NewReader("BenchmarkSolve-8 100 500 ns/op 0 B/op 0 allocs/op\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := ParseBench(strings.NewReader("BenchmarkSolve-8 100 500 ns/op 0 B/op 2 allocs/op\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type test struct {
+		change      float64
+		significant bool
+	}
+
+	tests := []test{
+		{0, false},          // ns/op
+		{0, false},          // B/op: still nothing
+		{math.Inf(1), true}, // allocs/op: allocating again
+	}
+
+	deltas := CompareBench(before, after)
+
+	if len(deltas) != len(tests) {
+		t.Fatalf("got %v deltas, want %v", len(deltas), len(tests))
+	}
+
+	for i, test := range tests {
+
+		d := deltas[i]
+
+		if d.Change != test.change || d.Significant() != test.significant {
+			t.Errorf("%v: got %v, %v, want %v, %v", d.Unit, d.Change, d.Significant(), test.change, test.significant)
+		}
+	}
+}
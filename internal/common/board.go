@@ -43,6 +43,42 @@ type Board[T any] struct {
 	visits    map[Position]int
 	paths     map[PositionWithDirection]int
 	overrides map[Position]T
+	steps     *[]PositionWithDirection // recorded by VisitPath, nil when not recording
+	wrap      bool
+	id        *owner   // shared by every copy of the board value
+	owners    []*owner // board allowed to write each row in place, see own
+}
+
+// owner tells apart boards sharing grid rows. It is not empty so
+// that every new one has a distinct address
+type owner struct{ _ byte }
+
+// setGrid makes grid the cells of b, with every row owned by b
+func (b *Board[T]) setGrid(grid [][]T) {
+
+	b.grid = grid
+	b.id = new(owner)
+	b.owners = make([]*owner, len(grid))
+
+	for y := range b.owners {
+		b.owners[y] = b.id
+	}
+}
+
+// share returns a board over the rows of b, which neither board owns
+// any more. Both copy a row before they first write it, and as the
+// owners are shared by every copy of the board value, so do those
+func (b *Board[T]) share() Board[T] {
+
+	for y := range b.owners {
+		b.owners[y] = nil
+	}
+
+	return Board[T]{
+		grid:   append([][]T(nil), b.grid...),
+		id:     new(owner),
+		owners: make([]*owner, len(b.grid)),
+	}
 }
 
 func (b *Board[T]) Get(p Position) (T, error) {
@@ -70,11 +106,22 @@ func (b *Board[T]) Set(p Position, v T) error {
 		return ErrOutOfBounds
 	}
 
+	b.own(p.Y)
+
 	b.grid[p.Y][p.X] = v
 
 	return nil
 }
 
+// own copies row y before it is written unless b owns it
+func (b *Board[T]) own(y int) {
+
+	if b.owners != nil && b.owners[y] != b.id {
+		b.grid[y] = append([]T(nil), b.grid[y]...)
+		b.owners[y] = b.id
+	}
+}
+
 func (b *Board[T]) Visit(p Position) error {
 
 	d := b.GetDimension()
@@ -114,10 +161,31 @@ func (b *Board[T]) VisitPath(p PositionWithDirection) error {
 
 	b.pos = p.Position
 
+	if b.steps != nil {
+		*b.steps = append(*b.steps, p)
+	}
+
 	// register the position
 	return b.Visit(p.Position)
 }
 
+// Record starts recording the steps given to VisitPath, dropping
+// any previously recorded. Like visits, steps are shared with
+// copies of the board, so walks over a copy are recorded too
+func (b *Board[T]) Record() {
+	b.steps = new([]PositionWithDirection)
+}
+
+// Steps returns the steps recorded since Record was called
+func (b *Board[T]) Steps() []PositionWithDirection {
+
+	if b.steps == nil {
+		return nil
+	}
+
+	return *b.steps
+}
+
 func (b *Board[T]) GetVisits(p Position) int {
 	return b.visits[p]
 }
@@ -195,34 +263,50 @@ func RemoveEmpty(s []string) []string {
 
 }
 
+// WithOverrides returns a board over the grid of b with the given
+// overrides on top of its own, and no visits or paths. The rows are
+// shared as with Snapshot, so neither board sees cells set on the
+// other
 func (b *Board[T]) WithOverrides(overrides map[Position]T) Board[T] {
-	b.overrides = overrides
-	return *b
+
+	o := b.share()
+
+	o.pos = b.pos
+	o.wrap = b.wrap
+	o.visits = make(map[Position]int)
+	o.paths = make(map[PositionWithDirection]int)
+	o.overrides = make(map[Position]T, len(b.overrides)+len(overrides))
+
+	for p, v := range b.overrides {
+		o.overrides[p] = v
+	}
+
+	for p, v := range overrides {
+		o.overrides[p] = v
+	}
+
+	return o
 }
 
 // ParseRune returns a Board with the given grid of
-// runes. Starts at position 0, 0 with no visits
+// runes. Starts at position 0, 0 with no visits.
+// Empty lines are skipped and rows are not checked
+// to be of the same length, see Parse for that
 func ParseRune(s []string) Board[rune] {
 
-	b := Board[rune]{
-		grid:   make([][]rune, len(RemoveEmpty(s))),
-		pos:    Position{0, 0},
-		visits: make(map[Position]int),
-		paths:  make(map[PositionWithDirection]int),
-	}
+	grid := make([][]rune, 0, len(s))
 
-	for i, line := range s {
+	for _, line := range s {
 
 		if len(line) == 0 {
 			continue
 		}
 
-		b.grid[i] = make([]rune, len(line))
-		for j, r := range line {
-			b.grid[i][j] = r
-		}
+		grid = append(grid, []rune(line))
 	}
 
+	b := newBoard(grid)
+
 	slog.Debug("Parsed board", "board size", b.GetDimension())
 
 	return b
@@ -231,6 +315,9 @@ func ParseRune(s []string) Board[rune] {
 
 // GetNeighbours returns the positions of neighbours given
 // a grid of N x M and a position p
+//
+// Deprecated: the map allocates and ranges in random order, use
+// AppendNeighbours instead
 func GetNeighbours(d Dimension, p Position) map[Direction]Position {
 
 	neighbours := make(map[Direction]Position)
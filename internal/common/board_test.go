@@ -2,6 +2,7 @@ package common
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -121,6 +122,18 @@ func TestParseRune(t *testing.T) {
 				{'B'},
 			},
 		},
+		{
+			input: []string{
+				"A",
+				"",
+				"B",
+				"",
+			},
+			grid: [][]rune{
+				{'A'},
+				{'B'},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -279,3 +292,71 @@ func TestGetNeighbours(t *testing.T) {
 		})
 	}
 }
+
+func benchmarkGrid(n int) []string {
+
+	lines := make([]string, n)
+
+	for i := range lines {
+		lines[i] = strings.Repeat(".#..^", n/5)
+	}
+
+	return lines
+}
+
+func BenchmarkParseRune(b *testing.B) {
+
+	s := benchmarkGrid(130)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		ParseRune(s)
+	}
+}
+
+func BenchmarkGetUnvisited(b *testing.B) {
+
+	board := ParseRune(benchmarkGrid(130))
+
+	for y := 0; y < 130; y += 2 {
+		for x := 0; x < 130; x++ {
+			board.Visit(Position{x, y})
+		}
+	}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		board.GetUnvisited()
+	}
+}
+
+func BenchmarkGetNeighbours(b *testing.B) {
+
+	d := Dimension{N: 130, M: 130}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, n := range GetNeighbours(d, Position{X: i % 130, Y: i / 130 % 130}) {
+			_ = n
+		}
+	}
+}
+
+func BenchmarkAppendNeighbours(b *testing.B) {
+
+	d := Dimension{N: 130, M: 130}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+
+		var buf [8]Neighbour
+
+		for _, n := range AppendNeighbours(buf[:0], d, Position{X: i % 130, Y: i / 130 % 130}, Connect8) {
+			_ = n
+		}
+	}
+}
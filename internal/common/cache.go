@@ -0,0 +1,307 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const inputFile = "input"
+
+var (
+	// Errors
+	ErrNotCached = errors.New("not cached")
+	ErrNoSession = errors.New("SESSION env not found")
+
+	cacheDir string
+)
+
+// CacheEntry describes a cached puzzle input
+type CacheEntry struct {
+	Year, Day int
+	Account   string // hash of the session token
+	Path      string
+	Size      int64
+	ModTime   time.Time
+}
+
+// Cache stores puzzle inputs on disk keyed by year, day
+// and a hash of the session token they were fetched with:
+//
+//	<Dir>/<year>/<account>/<day>/input
+type Cache struct {
+	Dir     string
+	Account string
+}
+
+// NewCache returns a cache rooted at dir for the given session token
+func NewCache(dir, session string) *Cache {
+	return &Cache{
+		Dir:     dir,
+		Account: SessionHash(session),
+	}
+}
+
+// SetCacheDir overrides the directory returned by CacheDir
+func SetCacheDir(dir string) {
+	cacheDir = dir
+}
+
+// CacheDir returns the directory where puzzle inputs are cached.
+// In order of preference: the one given to SetCacheDir, the
+// AOC_CACHE_DIR env or adventofcode under the user cache directory
+// ($XDG_CACHE_HOME on Linux)
+func CacheDir() (string, error) {
+
+	if cacheDir != "" {
+		return cacheDir, nil
+	}
+
+	if d, ok := os.LookupEnv("AOC_CACHE_DIR"); ok && d != "" {
+		return d, nil
+	}
+
+	d, err := os.UserCacheDir()
+
+	if err != nil {
+		return "", fmt.Errorf("could not find user cache directory: %v", err)
+	}
+
+	return filepath.Join(d, "adventofcode"), nil
+}
+
+// Session returns the session token from the SESSION env
+func Session() (string, error) {
+
+	s, ok := os.LookupEnv("SESSION")
+
+	if !ok || s == "" {
+		return "", ErrNoSession
+	}
+
+	return s, nil
+}
+
+// DefaultCache returns the cache for the current session
+// rooted at CacheDir
+func DefaultCache() (*Cache, error) {
+
+	session, err := Session()
+
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := CacheDir()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCache(dir, session), nil
+}
+
+// SessionHash returns a short, stable identifier for a session
+// token so it never needs to be written to disk
+func SessionHash(session string) string {
+	h := sha256.Sum256([]byte(session))
+	return hex.EncodeToString(h[:])[:12]
+}
+
+// Path returns the location of the input for the given year and day
+func (c *Cache) Path(year, day int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), c.Account, strconv.Itoa(day), inputFile)
+}
+
+// Get returns the cached input for the given year and day or
+// ErrNotCached if there is none
+func (c *Cache) Get(year, day int) ([]string, error) {
+
+	b, err := os.ReadFile(c.Path(year, day))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotCached
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read cached data: %v", err)
+	}
+
+	return strings.Split(string(b), "\n"), nil
+}
+
+// Put stores the input for the given year and day. The file is
+// written atomically so a failed write never leaves partial data
+func (c *Cache) Put(year, day int, data []string) error {
+
+	path := c.Path(year, day)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create cache directory: %v", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), inputFile+".*")
+
+	if err != nil {
+		return fmt.Errorf("could not write data to cache: %v", err)
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(strings.Join(data, "\n")); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write data to cache: %v", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not write data to cache: %v", err)
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// Invalidate removes the cached input for the given year and day
+func (c *Cache) Invalidate(year, day int) error {
+
+	err := os.Remove(c.Path(year, day))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotCached
+	}
+
+	return err
+}
+
+// List returns every cached input, for all accounts, sorted
+// by year, day and account
+func (c *Cache) List() ([]CacheEntry, error) {
+
+	var entries []CacheEntry
+
+	err := filepath.WalkDir(c.Dir, func(path string, d fs.DirEntry, err error) error {
+
+		if errors.Is(err, fs.ErrNotExist) && path == c.Dir {
+			return fs.SkipAll
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || d.Name() != inputFile {
+			return nil
+		}
+
+		e, ok := c.entry(path)
+
+		if !ok {
+			return nil
+		}
+
+		info, err := d.Info()
+
+		if err != nil {
+			return err
+		}
+
+		e.Size = info.Size()
+		e.ModTime = info.ModTime()
+
+		entries = append(entries, e)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Year != entries[j].Year {
+			return entries[i].Year < entries[j].Year
+		}
+
+		if entries[i].Day != entries[j].Day {
+			return entries[i].Day < entries[j].Day
+		}
+
+		return entries[i].Account < entries[j].Account
+	})
+
+	return entries, nil
+}
+
+// Prune removes the cached inputs fetched with any session
+// other than the current one and returns them
+func (c *Cache) Prune() ([]CacheEntry, error) {
+
+	entries, err := c.List()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []CacheEntry
+
+	for _, e := range entries {
+
+		if e.Account == c.Account {
+			continue
+		}
+
+		if err := os.Remove(e.Path); err != nil {
+			return pruned, err
+		}
+
+		// drop the day and account directories once empty
+		os.Remove(filepath.Dir(e.Path))
+		os.Remove(filepath.Dir(filepath.Dir(e.Path)))
+
+		pruned = append(pruned, e)
+	}
+
+	return pruned, nil
+}
+
+// entry parses a <year>/<account>/<day>/input path
+func (c *Cache) entry(path string) (CacheEntry, bool) {
+
+	rel, err := filepath.Rel(c.Dir, path)
+
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+
+	if len(parts) != 4 {
+		return CacheEntry{}, false
+	}
+
+	year, err := strconv.Atoi(parts[0])
+
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	day, err := strconv.Atoi(parts[2])
+
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	return CacheEntry{
+		Year:    year,
+		Day:     day,
+		Account: parts[1],
+		Path:    path,
+	}, true
+}
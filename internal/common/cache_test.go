@@ -0,0 +1,107 @@
+package common
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+
+	dir := t.TempDir()
+
+	c := NewCache(dir, "session-a")
+	other := NewCache(dir, "session-b")
+
+	if _, err := c.Get(2024, 1); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("got %v, want %v", err, ErrNotCached)
+	}
+
+	data := []string{"1 2", "3 4", ""}
+
+	if err := c.Put(2024, 1, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := other.Put(2024, 1, []string{"5 6"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Put(2023, 25, []string{"7"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Get(2024, 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("got %q, want %q", got, data)
+	}
+
+	want := filepath.Join(dir, "2024", SessionHash("session-a"), "1", "input")
+
+	if c.Path(2024, 1) != want {
+		t.Errorf("got %v, want %v", c.Path(2024, 1), want)
+	}
+
+	entries, err := c.List()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("got %v entries, want 3", len(entries))
+	}
+
+	if entries[0].Year != 2023 || entries[0].Day != 25 {
+		t.Errorf("got %v/%v, want 2023/25", entries[0].Year, entries[0].Day)
+	}
+
+	pruned, err := c.Prune()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pruned) != 1 || pruned[0].Account != other.Account {
+		t.Errorf("got %v, want only the entry of the other session", pruned)
+	}
+
+	if err := c.Invalidate(2024, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Invalidate(2024, 1); !errors.Is(err, ErrNotCached) {
+		t.Errorf("got %v, want %v", err, ErrNotCached)
+	}
+
+	entries, err = c.List()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("got %v entries, want 1", len(entries))
+	}
+}
+
+func TestCacheListMissingDir(t *testing.T) {
+
+	c := NewCache(filepath.Join(t.TempDir(), "missing"), "session")
+
+	entries, err := c.List()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("got %v, want no entries", entries)
+	}
+}
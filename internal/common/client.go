@@ -0,0 +1,228 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	UserAgent      = "github.com/wincus/adventofcode2024"
+
+	defaultTimeout = 30 * time.Second
+	defaultRetries = 3
+	defaultBackoff = time.Second
+	maxBackoff     = 30 * time.Second
+	maxBody        = 10 << 20
+)
+
+var (
+	// Errors
+	ErrSessionExpired = errors.New("session expired or invalid")
+	ErrNotUnlocked    = errors.New("puzzle not unlocked yet")
+	ErrRateLimited    = errors.New("rate limited")
+)
+
+// StatusError is returned when the server answers with a status
+// other than 200. Err holds the matching typed error, if any
+type StatusError struct {
+	Code int
+	Body string
+	Err  error
+}
+
+func (e *StatusError) Error() string {
+
+	if e.Err != nil {
+		return fmt.Sprintf("%v (status %v)", e.Err, e.Code)
+	}
+
+	return fmt.Sprintf("unexpected status %v: %v", e.Code, e.Body)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// Client talks to the Advent of Code website
+type Client struct {
+	BaseURL string       // defaults to DefaultBaseURL
+	Session string       // session cookie value
+	HTTP    *http.Client // its Timeout bounds every attempt
+	Retries int          // extra attempts on network errors, 429 and 5xx
+	Backoff time.Duration
+}
+
+// NewClient returns a client for the given session token with
+// sensible timeout and retry defaults. The AOC_BASE_URL env
+// points it to another server, such as a local fake
+func NewClient(session string) *Client {
+
+	base := DefaultBaseURL
+
+	if u, ok := os.LookupEnv("AOC_BASE_URL"); ok && u != "" {
+		base = u
+	}
+
+	return &Client{
+		BaseURL: base,
+		Session: session,
+		HTTP:    &http.Client{Timeout: defaultTimeout},
+		Retries: defaultRetries,
+		Backoff: defaultBackoff,
+	}
+}
+
+// Input returns the puzzle input for the given year and day
+func (c *Client) Input(ctx context.Context, year, day int) ([]string, error) {
+
+	b, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%v/day/%v/input", year, day), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(string(b), "\n"), nil
+}
+
+// do sends a request to path, retrying transient failures, and
+// returns the body of the first successful response
+func (c *Client) do(ctx context.Context, method, path string, form url.Values) ([]byte, error) {
+
+	var err error
+
+	for attempt := 0; ; attempt++ {
+
+		var b []byte
+		var wait time.Duration
+
+		b, wait, err = c.try(ctx, method, path, form)
+
+		if err == nil {
+			return b, nil
+		}
+
+		if wait < 0 || attempt >= c.Retries {
+			return nil, err
+		}
+
+		if wait == 0 {
+			wait = min(c.Backoff<<attempt, maxBackoff)
+		}
+
+		t := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// try performs a single attempt. A negative wait means the error
+// is permanent, otherwise it is the delay the server asked for
+// (zero for none)
+func (c *Client) try(ctx context.Context, method, path string, form url.Values) ([]byte, time.Duration, error) {
+
+	base := c.BaseURL
+
+	if base == "" {
+		base = DefaultBaseURL
+	}
+
+	var body io.Reader
+
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(base, "/")+path, body)
+
+	if err != nil {
+		return nil, -1, fmt.Errorf("could not create request: %v", err)
+	}
+
+	req.Header.Set("cookie", fmt.Sprintf("session=%v", c.Session))
+	req.Header.Set("User-Agent", UserAgent)
+
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	h := c.HTTP
+
+	if h == nil {
+		h = &http.Client{Timeout: defaultTimeout}
+	}
+
+	res, err := h.Do(req)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, -1, ctx.Err()
+		}
+
+		return nil, 0, err
+	}
+
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(res.Body, maxBody))
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not read response body: %v", err)
+	}
+
+	if res.StatusCode == http.StatusOK {
+		return b, 0, nil
+	}
+
+	e := &StatusError{
+		Code: res.StatusCode,
+		Body: strings.TrimSpace(string(b)),
+	}
+
+	switch {
+	case res.StatusCode == http.StatusBadRequest,
+		res.StatusCode == http.StatusUnauthorized,
+		res.StatusCode == http.StatusForbidden:
+		e.Err = ErrSessionExpired
+		return nil, -1, e
+
+	case res.StatusCode == http.StatusNotFound && strings.Contains(e.Body, "before it unlocks"):
+		e.Err = ErrNotUnlocked
+		return nil, -1, e
+
+	case res.StatusCode == http.StatusTooManyRequests:
+		e.Err = ErrRateLimited
+		return nil, retryAfter(res), e
+
+	case res.StatusCode >= 500:
+		return nil, 0, e
+	}
+
+	return nil, -1, e
+}
+
+// retryAfter returns the delay requested by the Retry-After
+// header, or zero if there is none
+func retryAfter(res *http.Response) time.Duration {
+
+	s, err := strconv.Atoi(res.Header.Get("Retry-After"))
+
+	if err != nil || s < 0 {
+		return 0
+	}
+
+	return min(time.Duration(s)*time.Second, maxBackoff)
+}
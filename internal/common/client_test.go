@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for a server answering with the
+// given statuses and bodies, one per request, repeating the last
+func newTestClient(t *testing.T, statuses []int, bodies []string) (*Client, *atomic.Int32) {
+
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Header.Get("cookie") != "session=token" {
+			t.Errorf("got cookie %q, want session=token", r.Header.Get("cookie"))
+		}
+
+		if r.URL.Path != "/2024/day/6/input" {
+			t.Errorf("got path %v, want /2024/day/6/input", r.URL.Path)
+		}
+
+		i := min(int(calls.Add(1))-1, len(statuses)-1)
+
+		w.WriteHeader(statuses[i])
+		w.Write([]byte(bodies[i]))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	c := NewClient("token")
+	c.BaseURL = srv.URL
+	c.Backoff = time.Millisecond
+
+	return c, &calls
+}
+
+func TestClientInput(t *testing.T) {
+
+	type test struct {
+		name     string
+		statuses []int
+		bodies   []string
+		want     []string
+		err      error
+		calls    int32
+	}
+
+	tests := []test{
+		{
+			name:     "ok",
+			statuses: []int{200},
+			bodies:   []string{"1\n2\n"},
+			want:     []string{"1", "2", ""},
+			calls:    1,
+		},
+		{
+			name:     "session expired",
+			statuses: []int{400},
+			bodies:   []string{"Puzzle inputs differ by user.  Please log in to get your puzzle input."},
+			err:      ErrSessionExpired,
+			calls:    1,
+		},
+		{
+			name:     "not unlocked",
+			statuses: []int{404},
+			bodies:   []string{"Please don't repeatedly request this endpoint before it unlocks! The calendar countdown is synchronized with the server time; the link will be enabled on the calendar the instant this puzzle becomes available."},
+			err:      ErrNotUnlocked,
+			calls:    1,
+		},
+		{
+			name:     "retry server error",
+			statuses: []int{500, 502, 200},
+			bodies:   []string{"oops", "oops", "42"},
+			want:     []string{"42"},
+			calls:    3,
+		},
+		{
+			name:     "rate limited",
+			statuses: []int{429},
+			bodies:   []string{"slow down"},
+			err:      ErrRateLimited,
+			calls:    4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			c, calls := newTestClient(t, test.statuses, test.bodies)
+
+			got, err := c.Input(context.Background(), 2024, 6)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("got %v, want %v", err, test.err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+
+			if calls.Load() != test.calls {
+				t.Errorf("got %v calls, want %v", calls.Load(), test.calls)
+			}
+		})
+	}
+}
+
+func TestClientStatusError(t *testing.T) {
+
+	c, _ := newTestClient(t, []int{503}, []string{"down"})
+	c.Retries = 0
+
+	_, err := c.Input(context.Background(), 2024, 6)
+
+	var e *StatusError
+
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want a *StatusError", err)
+	}
+
+	if e.Code != 503 || e.Body != "down" {
+		t.Errorf("got %v %q, want 503 \"down\"", e.Code, e.Body)
+	}
+}
+
+func TestGetDataDoesNotCacheErrors(t *testing.T) {
+
+	c, _ := newTestClient(t, []int{404, 200}, []string{"Please don't repeatedly request this endpoint before it unlocks!", "1\n2"})
+	cache := NewCache(t.TempDir(), "token")
+
+	if _, err := getData(context.Background(), c, cache, 2024, 6); !errors.Is(err, ErrNotUnlocked) {
+		t.Fatalf("got %v, want %v", err, ErrNotUnlocked)
+	}
+
+	if _, err := cache.Get(2024, 6); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("got %v, want %v", err, ErrNotCached)
+	}
+
+	if _, err := getData(context.Background(), c, cache, 2024, 6); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := cache.Get(2024, 6)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,146 @@
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"math"
+)
+
+// Clone returns a deep copy of b, sharing nothing with it
+func (b *Board[T]) Clone() Board[T] {
+
+	var c Board[T]
+
+	c.copyState(b)
+
+	grid := make([][]T, len(b.grid))
+
+	for y, row := range b.grid {
+		grid[y] = append([]T(nil), row...)
+	}
+
+	c.setGrid(grid)
+
+	return c
+}
+
+// Snapshot returns a copy of b that shares the grid rows with it
+// until either board, or any copy of its value, sets a cell in them.
+// Overrides, visits, paths and recorded steps are copied
+func (b *Board[T]) Snapshot() Board[T] {
+
+	c := b.share()
+
+	c.copyState(b)
+
+	return c
+}
+
+// copyState copies everything in b but the grid into c
+func (c *Board[T]) copyState(b *Board[T]) {
+
+	c.pos = b.pos
+	c.wrap = b.wrap
+	c.visits = maps.Clone(b.visits)
+	c.paths = maps.Clone(b.paths)
+	c.overrides = maps.Clone(b.overrides)
+
+	if c.visits == nil {
+		c.visits = make(map[Position]int)
+	}
+
+	if c.paths == nil {
+		c.paths = make(map[PositionWithDirection]int)
+	}
+
+	if c.overrides == nil {
+		c.overrides = make(map[Position]T)
+	}
+
+	if b.steps != nil {
+		steps := append([]PositionWithDirection(nil), *b.steps...)
+		c.steps = &steps
+	}
+}
+
+// Equal reports whether a and b have the same dimension and cells,
+// as returned by Get. Visits, paths and positions are ignored
+func Equal[T comparable](a, b *Board[T]) bool {
+
+	d := a.GetDimension()
+
+	if d != b.GetDimension() {
+		return false
+	}
+
+	for y := 0; y < d.N; y++ {
+		for x := 0; x < d.M; x++ {
+
+			p := Position{X: x, Y: y}
+
+			va, _ := a.Get(p)
+			vb, _ := b.Get(p)
+
+			if va != vb {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash of the dimension and cells of b, as returned
+// by Get. Boards that are Equal have the same hash, and it does not
+// change between runs, so it can be stored
+func (b *Board[T]) Hash() uint64 {
+
+	h := fnv.New64a()
+
+	var buf [8]byte
+
+	put := func(n uint64) {
+		binary.LittleEndian.PutUint64(buf[:], n)
+		h.Write(buf[:])
+	}
+
+	d := b.GetDimension()
+
+	put(uint64(d.N))
+	put(uint64(d.M))
+
+	for y := 0; y < d.N; y++ {
+		for x := 0; x < d.M; x++ {
+
+			v, _ := b.Get(Position{X: x, Y: y})
+
+			switch c := any(v).(type) {
+			case rune:
+				put(uint64(c))
+			case byte:
+				put(uint64(c))
+			case int:
+				put(uint64(c))
+			case int64:
+				put(uint64(c))
+			case bool:
+				if c {
+					put(1)
+				} else {
+					put(0)
+				}
+			case float64:
+				put(math.Float64bits(c))
+			case string:
+				put(uint64(len(c)))
+				h.Write([]byte(c))
+			default:
+				fmt.Fprintf(h, "%v\x00", v)
+			}
+		}
+	}
+
+	return h.Sum64()
+}
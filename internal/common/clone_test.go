@@ -0,0 +1,166 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+
+	b := ParseRune([]string{
+		"ab",
+		"cd",
+	})
+
+	b.Visit(Position{X: 1, Y: 1})
+
+	c := b.Clone()
+
+	c.Set(Position{X: 0, Y: 0}, 'z')
+	c.Visit(Position{X: 0, Y: 0})
+
+	if v, _ := b.Get(Position{X: 0, Y: 0}); v != 'a' {
+		t.Errorf("got %c, want %c", v, 'a')
+	}
+
+	if got := b.GetVisits(Position{X: 0, Y: 0}); got != 0 {
+		t.Errorf("got %v visits, want %v", got, 0)
+	}
+
+	if got := c.GetVisits(Position{X: 1, Y: 1}); got != 1 {
+		t.Errorf("got %v visits, want %v", got, 1)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+
+	b := ParseRune([]string{
+		"ab",
+		"cd",
+	})
+
+	s := b.Snapshot()
+
+	s.Set(Position{X: 0, Y: 0}, 'z')
+	b.Set(Position{X: 1, Y: 1}, 'y')
+
+	// a snapshot taken from a copy of the board value
+	snapshot := func(b Board[rune]) Board[rune] { return b.Snapshot() }
+
+	v := snapshot(b)
+
+	b.Set(Position{X: 0, Y: 1}, 'x')
+
+	// copies of the board value still share its cells
+	c := b
+
+	c.Set(Position{X: 1, Y: 0}, 'w')
+
+	type test struct {
+		name string
+		b    *Board[rune]
+		want []string
+	}
+
+	tests := []test{
+		{name: "original", b: &b, want: []string{"aw", "xy"}},
+		{name: "snapshot", b: &s, want: []string{"zb", "cd"}},
+		{name: "snapshot of a copy", b: &v, want: []string{"ab", "cy"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			want := ParseRune(test.want)
+
+			if !Equal(test.b, &want) {
+				t.Errorf("got %v, want %v", lines(*test.b), test.want)
+			}
+		})
+	}
+}
+
+func TestWithOverrides(t *testing.T) {
+
+	b := ParseRune([]string{
+		"ab",
+		"cd",
+	})
+
+	b.VisitPath(PositionWithDirection{Position{X: 0, Y: 0}, Right})
+
+	o := b.WithOverrides(map[Position]rune{{X: 1, Y: 0}: 'O'})
+
+	o.VisitPath(PositionWithDirection{Position{X: 1, Y: 0}, Right})
+
+	if v, _ := b.Get(Position{X: 1, Y: 0}); v != 'b' {
+		t.Errorf("got %c, want %c on the original board", v, 'b')
+	}
+
+	if v, _ := o.Get(Position{X: 1, Y: 0}); v != 'O' {
+		t.Errorf("got %c, want %c on the overridden board", v, 'O')
+	}
+
+	if got := o.GetPaths(PositionWithDirection{Position{X: 0, Y: 0}, Right}); got != 0 {
+		t.Errorf("got %v paths, want %v on the overridden board", got, 0)
+	}
+
+	if got := b.GetPaths(PositionWithDirection{Position{X: 1, Y: 0}, Right}); got != 0 {
+		t.Errorf("got %v paths, want %v on the original board", got, 0)
+	}
+
+	if err := o.Set(Position{X: 0, Y: 1}, 'x'); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := b.Get(Position{X: 0, Y: 1}); v != 'c' {
+		t.Errorf("got %c, want %c on the original board", v, 'c')
+	}
+
+	if v, _ := o.Get(Position{X: 0, Y: 1}); v != 'x' {
+		t.Errorf("got %c, want %c on the overridden board", v, 'x')
+	}
+
+	if err := b.Set(Position{X: 1, Y: 1}, 'y'); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := o.Get(Position{X: 1, Y: 1}); v != 'd' {
+		t.Errorf("got %c, want %c on the overridden board", v, 'd')
+	}
+}
+
+func TestEqualAndHash(t *testing.T) {
+
+	a := ParseRune([]string{"ab", "cd"})
+	b := ParseRune([]string{"ab", "cd"})
+	c := ParseRune([]string{"abcd"})
+	x := ParseRune([]string{"ab", "cx"})
+	d := x.WithOverrides(map[Position]rune{{X: 1, Y: 1}: 'd'})
+
+	b.Visit(Position{X: 1, Y: 1})
+
+	type test struct {
+		name string
+		x, y *Board[rune]
+		want bool
+	}
+
+	tests := []test{
+		{name: "same cells", x: &a, y: &b, want: true},
+		{name: "other shape", x: &a, y: &c, want: false},
+		{name: "overridden", x: &a, y: &d, want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			if got := Equal(test.x, test.y); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+
+			if got := test.x.Hash() == test.y.Hash(); got != test.want {
+				t.Errorf("got equal hashes %v, want %v", got, test.want)
+			}
+		})
+	}
+}
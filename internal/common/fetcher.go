@@ -1,100 +1,70 @@
 package common
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
-	"net/url"
-	"os"
-	"strings"
 )
 
-func GetData(n int) ([]string, error) {
+// GetData returns the input for the day of year, using the cached
+// copy when available
+func GetData(year, day int) ([]string, error) {
 
-	path := fmt.Sprintf("/tmp/data/%v", n)
-
-	// check if the data is already cached
-	if _, err := os.Stat(path); err == nil {
-
-		slog.Info("using cached data", "day", n, "path", path)
-
-		b, err := os.ReadFile(path)
-
-		if err != nil {
-			return nil, fmt.Errorf("could not read cached data: %v", err)
-		}
-
-		return strings.Split(string(b), "\n"), nil
-	}
-
-	data, err := getData(n)
+	session, err := Session()
 
 	if err != nil {
-		return nil, fmt.Errorf("could not get data: %v", err)
-	}
-
-	// create the cache directory if it does not exist
-	if _, err := os.Stat("/tmp/data"); os.IsNotExist(err) {
-		err = os.Mkdir("/tmp/data", 0755)
-		if err != nil {
-			return nil, fmt.Errorf("could not create cache directory: %v", err)
-		}
+		return nil, err
 	}
 
-	err = os.WriteFile(path, []byte(strings.Join(data, "\n")), 0644)
+	c, err := DefaultCache()
 
 	if err != nil {
-		return nil, fmt.Errorf("could not write data to cache: %v", err)
+		return nil, err
 	}
 
-	return data, nil
+	return getData(context.Background(), NewClient(session), c, year, day)
 
 }
 
-// GetData retrieves data used to solve day n
-func getData(n int) ([]string, error) {
+// CachedData returns the cached input for the day of year without
+// ever fetching it, as needed by tests and benchmarks
+func CachedData(year, day int) ([]string, error) {
 
-	var data []string
-
-	h, ok := os.LookupEnv("SESSION")
-
-	if !ok {
-		return nil, fmt.Errorf("SESSION env not found")
-	}
-
-	// https://adventofcode.com/2024/day/%v/input
-	u := &url.URL{
-		Scheme: "https",
-		Host:   "adventofcode.com",
-		Path:   fmt.Sprintf("%v/day/%v/input", 2024, n),
-	}
-
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	c, err := DefaultCache()
 
 	if err != nil {
-		return nil, fmt.Errorf("could not create request: %v", err)
+		return nil, err
 	}
 
-	req.Header.Set("cookie", fmt.Sprintf("session=%v", h))
+	return c.Get(year, day)
+}
 
-	c := &http.Client{}
+// getData returns the input for the given year and day from the
+// cache, fetching and caching it on a miss. Only successful
+// responses ever reach the cache
+func getData(ctx context.Context, client *Client, c *Cache, year, day int) ([]string, error) {
 
-	res, err := c.Do(req)
+	data, err := c.Get(year, day)
 
-	if err != nil {
+	if err == nil {
+		slog.Info("using cached data", "year", year, "day", day, "path", c.Path(year, day))
+		return data, nil
+	}
+
+	if !errors.Is(err, ErrNotCached) {
 		return nil, err
 	}
 
-	b, err := io.ReadAll(res.Body)
+	data, err = client.Input(ctx, year, day)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not read response body: %v", err)
+		return nil, fmt.Errorf("could not get data: %w", err)
 	}
 
-	defer res.Body.Close()
-
-	data = strings.Split(string(b), "\n")
+	if err := c.Put(year, day, data); err != nil {
+		return nil, err
+	}
 
 	return data, nil
 
@@ -1,27 +1,39 @@
 package common
 
 import (
+	"context"
 	"fmt"
-	"html/template"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
 )
 
 const UTILS = `package day{{.Day}}
 
 import (
+	"context"
+
 	"github.com/wincus/adventofcode2024/internal/common"
 )
 
+func init() {
+	common.Register({{.Year}}, {{.Day}}, Solve)
+}
+
 // Solve returns the solutions for day {{.Day}}
-func Solve(s []string, p common.Part) int {
-	return 0
+func Solve(ctx context.Context, s []string, p common.Part) (common.Answer, error) {
+	return common.Int(0), nil
 }
 `
 
 const TEST = `package day{{.Day}}
 
 import (
+	"context"
 	"testing"
 
 	"github.com/wincus/adventofcode2024/internal/common"
@@ -36,61 +48,133 @@ type Test struct {
 func TestSolver(t *testing.T) {
 
 	tests := []Test{
+{{- range .Examples}}
 		{
-			input: []string{},
-			p:     common.Part1,
-			want:  0,
-		},
-		{
-			input: []string{},
-			p:     common.Part2,
-			want:  0,
+			input: []string{ {{- if .Input}}
+{{- range .Input}}
+				{{printf "%q" .}},
+{{- end}}
+			{{end -}} },
+{{- if .Input}}
+			p:    common.Part{{.Part}},
+			want: {{.Want}},
+{{- else}}
+			p:     common.Part{{.Part}},
+			want:  {{.Want}},
+{{- end}}
 		},
+{{- end}}
 	}
 
 	for _, test := range tests {
-		got := Solve(test.input, test.p)
+		got, err := Solve(context.Background(), test.input, test.p)
+
+		if err != nil {
+			t.Fatalf("unexpected error for part %v: %v", test.p, err)
+		}
 
-		if got != test.want {
+		if !got.Equal(common.Int(test.want)) {
 			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
 		}
 	}
 }
 `
 
-const MAIN = `package main
+const BENCH = `package day{{.Day}}
 
 import (
-	"log"
+	"context"
+	"testing"
 
 	"github.com/wincus/adventofcode2024/internal/common"
-	"github.com/wincus/adventofcode2024/internal/day{{.Day}}"
 )
 
-func main() {
+// BenchmarkSolve solves both parts with your cached input, skipping
+// when it has not been fetched yet
+func BenchmarkSolve(b *testing.B) {
 
-	d, err := common.GetData({{.Day}})
+	s, err := common.CachedData({{.Year}}, {{.Day}})
 
 	if err != nil {
-		log.Panicf("no data, no game ... sorry!")
+		b.Skipf("no cached input: %v", err)
 	}
 
 	for _, p := range []common.Part{common.Part1, common.Part2} {
-		log.Printf("Solution for Part %v: %v", p, day{{.Day}}.Solve(d, p))
+		b.Run("Part"+p.String(), func(b *testing.B) {
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := Solve(context.Background(), s, p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
 	}
 }
 `
 
+const DAYS = `// Code generated by cmd/generate; DO NOT EDIT.
+
+// Package days links every puzzle package into the binary
+// so that their solvers get registered
+package days
+
+import ({{range .}}
+	_ "github.com/wincus/adventofcode2024/internal/{{.Year}}/day{{.Day}}"{{end}}
+)
+`
+
 type data struct {
-	Day string
+	Year     string
+	Day      string
+	Examples []Example
 }
 
-func Generate(day string) error {
+// Generate scaffolds the package for the day of year under
+// internal/<year>/day<day>. With fetch, the puzzle description is
+// saved as puzzle.md and its examples seed the tests
+func Generate(year, day string, fetch bool) error {
 
 	if day == "" {
 		panic("day number is required")
 	}
 
+	if year == "" {
+		year = strconv.Itoa(DefaultYear)
+	}
+
+	examples := []Example{
+		{Part: Part1},
+		{Part: Part2},
+	}
+
+	var description string
+
+	if fetch {
+
+		p, err := fetchPuzzle(year, day)
+
+		if err != nil {
+			return err
+		}
+
+		description = p.Markdown
+
+		for _, e := range p.Examples {
+			if e.Part == Part1 || e.Part == Part2 {
+				examples[e.Part-1] = e
+			}
+		}
+	}
+
+	for i, e := range examples {
+		// the test table expects an int
+		if _, err := strconv.Atoi(e.Want); err != nil {
+			examples[i].Want = "0"
+		}
+	}
+
 	u, err := template.New("utils").Parse(UTILS)
 
 	if err != nil {
@@ -103,13 +187,13 @@ func Generate(day string) error {
 		return err
 	}
 
-	m, err := template.New("main").Parse(MAIN)
+	bt, err := template.New("bench").Parse(BENCH)
 
 	if err != nil {
 		return err
 	}
 
-	d := fmt.Sprintf("internal/day%s", day)
+	d := fmt.Sprintf("internal/%s/day%s", year, day)
 
 	_, err = os.Stat(d)
 
@@ -123,41 +207,138 @@ func Generate(day string) error {
 		return err
 	}
 
-	e := fmt.Sprintf("solutions/day%s", day)
+	fUtils, _ := os.Create(fmt.Sprintf("%s/utils.go", d))
+	defer fUtils.Close()
 
-	err = os.MkdirAll(e, 0755)
+	err = u.Execute(fUtils, data{year, day, examples})
 
 	if err != nil {
 		return err
 	}
 
-	fMain, _ := os.Create(fmt.Sprintf("%s/main.go", e))
-	defer fMain.Close()
+	fTest, _ := os.Create(fmt.Sprintf("%s/utils_test.go", d))
+	defer fTest.Close()
 
-	err = m.Execute(fMain, data{day})
+	err = t.Execute(fTest, data{year, day, examples})
 
 	if err != nil {
 		return err
 	}
 
-	fUtils, _ := os.Create(fmt.Sprintf("%s/utils.go", d))
-	defer fUtils.Close()
+	fBench, _ := os.Create(fmt.Sprintf("%s/bench_test.go", d))
+	defer fBench.Close()
 
-	err = u.Execute(fUtils, data{day})
+	err = bt.Execute(fBench, data{year, day, examples})
 
 	if err != nil {
 		return err
 	}
 
-	fTest, _ := os.Create(fmt.Sprintf("%s/utils_test.go", d))
-	defer fTest.Close()
+	if description != "" {
+
+		err = os.WriteFile(fmt.Sprintf("%s/puzzle.md", d), []byte(description), 0644)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return GenerateDays()
+
+}
+
+// fetchPuzzle downloads and parses the description of the day of year
+func fetchPuzzle(year, day string) (Puzzle, error) {
+
+	y, err := strconv.Atoi(year)
 
-	err = t.Execute(fTest, data{day})
+	if err != nil {
+		return Puzzle{}, fmt.Errorf("invalid year %q", year)
+	}
+
+	n, err := strconv.Atoi(day)
+
+	if err != nil {
+		return Puzzle{}, fmt.Errorf("invalid day %q", day)
+	}
+
+	session, err := Session()
+
+	if err != nil {
+		return Puzzle{}, err
+	}
+
+	page, err := NewClient(session).Puzzle(context.Background(), y, n)
+
+	if err != nil {
+		return Puzzle{}, fmt.Errorf("could not get puzzle: %w", err)
+	}
+
+	return ParsePuzzle(page)
+}
+
+// GenerateDays rewrites internal/days/days.go so that it imports
+// every internal/<year>/dayN package found in the tree
+func GenerateDays() error {
+
+	tmpl, err := template.New("days").Parse(DAYS)
+
+	if err != nil {
+		return err
+	}
+
+	dirs, err := filepath.Glob("internal/*/day*")
 
 	if err != nil {
 		return err
 	}
 
-	return nil
+	var days []puzzle
+
+	for _, dir := range dirs {
+
+		y, err := strconv.Atoi(filepath.Base(filepath.Dir(dir)))
+
+		if err != nil {
+			continue
+		}
+
+		n, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "day"))
+
+		if err != nil {
+			continue
+		}
+
+		days = append(days, puzzle{y, n})
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].year != days[j].year {
+			return days[i].year < days[j].year
+		}
+
+		return days[i].day < days[j].day
+	})
+
+	err = os.MkdirAll("internal/days", 0755)
+
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create("internal/days/days.go")
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	var packages []data
+
+	for _, d := range days {
+		packages = append(packages, data{Year: strconv.Itoa(d.year), Day: strconv.Itoa(d.day)})
+	}
 
+	return tmpl.Execute(f, packages)
 }
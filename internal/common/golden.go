@@ -0,0 +1,124 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const goldenFile = "golden.json"
+
+var (
+	// Errors
+	ErrNoGolden = errors.New("no golden answer")
+	ErrMismatch = errors.New("answer does not match golden answer")
+)
+
+// Golden keeps the accepted answers of a year for a session. They
+// live in the cache directory since inputs, and so answers, differ
+// by user
+type Golden struct {
+	path    string
+	Answers map[int]map[Part]string `json:"answers"`
+}
+
+// GoldenPath returns the location of the golden answers for year
+func (c *Cache) GoldenPath(year int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), c.Account, goldenFile)
+}
+
+// LoadGolden reads the golden answers stored at path. A missing
+// file holds no answers
+func LoadGolden(path string) (*Golden, error) {
+
+	g := &Golden{
+		path:    path,
+		Answers: make(map[int]map[Part]string),
+	}
+
+	b, err := os.ReadFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return g, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read golden answers: %v", err)
+	}
+
+	if err := json.Unmarshal(b, g); err != nil {
+		return nil, fmt.Errorf("could not parse golden answers: %v", err)
+	}
+
+	return g, nil
+}
+
+// Get returns the golden answer for the given part of day
+func (g *Golden) Get(day int, p Part) (string, bool) {
+	a, ok := g.Answers[day][p]
+	return a, ok
+}
+
+// Set records answer as golden for the given part of day and
+// saves the file
+func (g *Golden) Set(day int, p Part, answer string) error {
+
+	if g.Answers[day] == nil {
+		g.Answers[day] = make(map[Part]string)
+	}
+
+	g.Answers[day][p] = answer
+
+	b, err := json.MarshalIndent(g, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(g.path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(g.path, b, 0600)
+}
+
+// CheckGolden solves the given part of a day with its cached input
+// and compares the answer with the golden one. It never fetches
+// inputs, returning ErrNotCached instead
+func CheckGolden(ctx context.Context, c *Cache, g *Golden, year, day int, p Part) (Answer, error) {
+
+	want, ok := g.Get(day, p)
+
+	if !ok {
+		return Answer{}, ErrNoGolden
+	}
+
+	solve, err := GetSolver(year, day)
+
+	if err != nil {
+		return Answer{}, err
+	}
+
+	input, err := c.Get(year, day)
+
+	if err != nil {
+		return Answer{}, err
+	}
+
+	got, err := solve(ctx, input, p)
+
+	if err != nil {
+		return Answer{}, err
+	}
+
+	if got.String() != want {
+		return got, fmt.Errorf("%w: got %v, want %v", ErrMismatch, got, want)
+	}
+
+	return got, nil
+}
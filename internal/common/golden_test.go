@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckGolden(t *testing.T) {
+
+	// a year no real puzzle uses
+	Register(1, 1, func(ctx context.Context, s []string, p Part) (Answer, error) {
+		return Int(len(Trim(s)) * int(p)), nil
+	})
+
+	t.Cleanup(func() { unregister(1, 1) })
+
+	dir := t.TempDir()
+	c := NewCache(dir, "session")
+
+	if err := c.Put(1, 1, []string{"a", "b", "c", ""}); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := LoadGolden(c.GoldenPath(1))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.Set(1, Part1, "3"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.Set(1, Part2, "7"); err != nil {
+		t.Fatal(err)
+	}
+
+	// reload to check the answers survive a round trip
+	g, err = LoadGolden(filepath.Join(dir, "1", c.Account, "golden.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := CheckGolden(context.Background(), c, g, 1, 1, Part1); err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+
+	if got, err := CheckGolden(context.Background(), c, g, 1, 1, Part2); !errors.Is(err, ErrMismatch) || !got.Equal(Int(6)) {
+		t.Errorf("got %v, %v, want 6, %v", got, err, ErrMismatch)
+	}
+
+	if err := g.Set(2, Part1, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := CheckGolden(context.Background(), c, g, 1, 2, Part2); !errors.Is(err, ErrNoGolden) {
+		t.Errorf("got %v, want %v", err, ErrNoGolden)
+	}
+
+	Register(1, 2, func(ctx context.Context, s []string, p Part) (Answer, error) {
+		return Int(0), nil
+	})
+
+	t.Cleanup(func() { unregister(1, 2) })
+
+	if _, err := CheckGolden(context.Background(), c, g, 1, 2, Part1); !errors.Is(err, ErrNotCached) {
+		t.Errorf("got %v, want %v", err, ErrNotCached)
+	}
+}
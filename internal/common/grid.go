@@ -0,0 +1,117 @@
+package common
+
+// Grid is a two dimensional board of cells that keeps track of the
+// visits and paths walked over it. It is implemented by the dense
+// Board and by SparseBoard
+type Grid[T any] interface {
+	Get(p Position) (T, error)
+	Set(p Position, v T) error
+	Visit(p Position) error
+	VisitPath(p PositionWithDirection) error
+	GetVisits(p Position) int
+	GetPaths(p PositionWithDirection) int
+	GetVisited() []Position
+	GetPosition() Position
+
+	// Bounds returns the rectangle holding the cells of the grid
+	Bounds() Rect
+
+	// Move returns the position next to p in direction d, wrapping
+	// around if the grid does, and false if it falls out of bounds
+	Move(p Position, d Direction) (Position, bool)
+}
+
+var (
+	_ Grid[rune] = (*Board[rune])(nil)
+	_ Grid[rune] = (*SparseBoard[rune])(nil)
+)
+
+// Neighbour is a position next to another one and the direction
+// leading to it
+type Neighbour struct {
+	Direction Direction
+	Position  Position
+}
+
+// AppendNeighbours appends to dst the positions next to p on a grid
+// of dimension d, clockwise from Up, and returns the extended slice.
+// c must be Connect4 or Connect8, it panics otherwise. Passing a
+// slice with room for 8 avoids allocating:
+//
+//	var buf [8]Neighbour
+//	for _, n := range AppendNeighbours(buf[:0], d, p, Connect8) {
+func AppendNeighbours(dst []Neighbour, d Dimension, p Position, c Connectivity) []Neighbour {
+
+	for _, dir := range c.directions() {
+		if n := p.Add(dir.Delta()); CheckPos(d, n) {
+			dst = append(dst, Neighbour{dir, n})
+		}
+	}
+
+	return dst
+}
+
+// Neighbours is AppendNeighbours honouring the bounds and wrapping
+// of any grid
+func Neighbours[T any](dst []Neighbour, g Grid[T], p Position, c Connectivity) []Neighbour {
+
+	for _, dir := range c.directions() {
+		if n, ok := g.Move(p, dir); ok {
+			dst = append(dst, Neighbour{dir, n})
+		}
+	}
+
+	return dst
+}
+
+// Bounds returns the rectangle covered by the board
+func (b *Board[T]) Bounds() Rect {
+	d := b.GetDimension()
+	return Rect{Max: Position{X: d.M - 1, Y: d.N - 1}}
+}
+
+// SetWrap makes moves past an edge of the board come back from the
+// opposite one. Wrapping only applies to Move, and so to Neighbours,
+// Moves, WeightedMoves, TurningMoves, FloodFill and Regions built on
+// it. Get, Set, Visit, Ray and Cast still treat positions past an
+// edge as out of bounds, and Region measures do not wrap
+func (b *Board[T]) SetWrap(wrap bool) {
+	b.wrap = wrap
+}
+
+// Move returns the position next to p in direction d
+func (b *Board[T]) Move(p Position, d Direction) (Position, bool) {
+
+	o := d.Delta()
+
+	if o == (Position{}) {
+		return p, false
+	}
+
+	return step(b.Bounds(), b.wrap, p, o)
+}
+
+// step moves p by o within r, wrapping around its edges if asked to
+func step(r Rect, wrap bool, p, o Position) (Position, bool) {
+
+	n := p.Add(o)
+
+	if r.Contains(n) {
+		return n, true
+	}
+
+	if !wrap {
+		return n, false
+	}
+
+	d := r.Dimension()
+
+	if d.N <= 0 || d.M <= 0 {
+		return n, false
+	}
+
+	n.X = r.Min.X + ((n.X-r.Min.X)%d.M+d.M)%d.M
+	n.Y = r.Min.Y + ((n.Y-r.Min.Y)%d.N+d.N)%d.N
+
+	return n, true
+}
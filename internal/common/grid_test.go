@@ -0,0 +1,213 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNeighbours(t *testing.T) {
+
+	dense := ParseRune([]string{
+		"...",
+		"...",
+		"...",
+	})
+
+	wrapped := ParseRune([]string{
+		"...",
+		"...",
+		"...",
+	})
+
+	wrapped.SetWrap(true)
+
+	bounded := NewSparseBoard[rune](&Rect{Min: Position{X: -1, Y: -1}, Max: Position{X: 1, Y: 1}})
+	bounded.SetWrap(true)
+
+	type test struct {
+		name string
+		g    Grid[rune]
+		p    Position
+		c    Connectivity
+		want map[Direction]Position
+	}
+
+	tests := []test{
+		{
+			name: "dense corner",
+			g:    &dense,
+			p:    Position{X: 0, Y: 0},
+			c:    Connect4,
+			want: map[Direction]Position{Down: {X: 0, Y: 1}, Right: {X: 1, Y: 0}},
+		},
+		{
+			name: "dense wrapped corner",
+			g:    &wrapped,
+			p:    Position{X: 0, Y: 0},
+			c:    Connect4,
+			want: map[Direction]Position{Up: {X: 0, Y: 2}, Down: {X: 0, Y: 1}, Left: {X: 2, Y: 0}, Right: {X: 1, Y: 0}},
+		},
+		{
+			name: "sparse unbounded",
+			g:    NewSparseBoard[rune](nil),
+			p:    Position{X: -100, Y: 1000},
+			c:    Connect4,
+			want: map[Direction]Position{Up: {X: -100, Y: 999}, Down: {X: -100, Y: 1001}, Left: {X: -101, Y: 1000}, Right: {X: -99, Y: 1000}},
+		},
+		{
+			name: "sparse wrapped corner",
+			g:    bounded,
+			p:    Position{X: 1, Y: 1},
+			c:    Connect8,
+			want: map[Direction]Position{
+				Up:        {X: 1, Y: 0},
+				Down:      {X: 1, Y: -1},
+				Left:      {X: 0, Y: 1},
+				Right:     {X: -1, Y: 1},
+				Upleft:    {X: 0, Y: 0},
+				Upright:   {X: -1, Y: 0},
+				Downleft:  {X: 0, Y: -1},
+				Downright: {X: -1, Y: -1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			got := make(map[Direction]Position)
+
+			for _, n := range Neighbours(nil, test.g, test.p, test.c) {
+				got[n.Direction] = n.Position
+			}
+
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+
+			for d, p := range test.want {
+				if got[d] != p {
+					t.Errorf("got %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendNeighbours(t *testing.T) {
+
+	d := Dimension{N: 3, M: 3}
+
+	type test struct {
+		name string
+		p    Position
+		c    Connectivity
+		want []Direction
+	}
+
+	tests := []test{
+		{name: "center", p: Position{X: 1, Y: 1}, c: Connect4, want: []Direction{Up, Right, Down, Left}},
+		{name: "center diagonals", p: Position{X: 1, Y: 1}, c: Connect8, want: []Direction{Up, Upright, Right, Downright, Down, Downleft, Left, Upleft}},
+		{name: "corner", p: Position{X: 2, Y: 0}, c: Connect8, want: []Direction{Down, Downleft, Left}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			got := AppendNeighbours(nil, d, test.p, test.c)
+
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+
+			// same neighbours as GetNeighbours, in a fixed order
+			old := GetNeighbours(d, test.p)
+
+			for i, n := range got {
+				if n.Direction != test.want[i] || n.Position != old[n.Direction] {
+					t.Errorf("got %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		var buf [8]Neighbour
+		AppendNeighbours(buf[:0], d, Position{X: 1, Y: 1}, Connect8)
+	})
+
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+
+	for _, c := range []Connectivity{0, Connect6, Connect26} {
+		func() {
+
+			defer func() {
+				if recover() == nil {
+					t.Errorf("got no panic, want one for connectivity %v", c)
+				}
+			}()
+
+			AppendNeighbours(nil, d, Position{X: 1, Y: 1}, c)
+		}()
+	}
+}
+
+func TestSparseBoard(t *testing.T) {
+
+	b := NewSparseBoard[rune](nil)
+
+	if v, err := b.Get(Position{X: 1 << 40, Y: -5}); err != nil || v != 0 {
+		t.Errorf("got %v, %v, want the zero value", v, err)
+	}
+
+	b.Set(Position{X: -2, Y: 3}, '#')
+	b.Set(Position{X: 4, Y: 1}, '#')
+	b.VisitPath(PositionWithDirection{Position{X: 0, Y: 5}, Up})
+
+	want := Rect{Min: Position{X: -2, Y: 1}, Max: Position{X: 4, Y: 5}}
+
+	if got := b.Bounds(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := b.Len(); got != 2 {
+		t.Errorf("got %v, want %v", got, 2)
+	}
+
+	bounded := NewSparseBoard[rune](&Rect{Max: Position{X: 2, Y: 2}})
+
+	if err := bounded.Set(Position{X: 3, Y: 0}, '#'); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("got %v, want %v", err, ErrOutOfBounds)
+	}
+}
+
+func TestRenderSparse(t *testing.T) {
+
+	b := SparseFrom(&Board[rune]{grid: [][]rune{
+		[]rune("#.."),
+		[]rune("..#"),
+	}}, func(r rune) bool { return r == '#' })
+
+	b.Visit(Position{X: 1, Y: 0})
+	b.Visit(Position{X: 1, Y: 1})
+
+	var sb strings.Builder
+
+	if err := RenderGrid[rune](&sb, b, RenderOptions[rune]{Format: func(r rune) string {
+		if r == 0 {
+			return "."
+		}
+		return string(r)
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "#X.\n.@#\n"
+
+	if got := sb.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
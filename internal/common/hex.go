@@ -0,0 +1,179 @@
+package common
+
+// Hex is a cell of a hexagonal grid in axial coordinates. The third
+// cube coordinate is S, so that Q+R+S is always zero
+type Hex struct {
+	Q, R int
+}
+
+// HexDirection is one of the six sides of a hexagon, named for a
+// pointy-top layout. On flat-top layouts the same values are rotated
+// by 30 degrees, so East is southeast and Northwest is north
+type HexDirection int
+
+const (
+	East HexDirection = iota
+	Northeast
+	Northwest
+	West
+	Southwest
+	Southeast
+)
+
+// hexDirections holds every side counterclockwise from East
+var hexDirections = [...]HexDirection{East, Northeast, Northwest, West, Southwest, Southeast}
+
+// HexDirections returns a copy of every side counterclockwise from
+// East
+func HexDirections() [6]HexDirection {
+	return hexDirections
+}
+
+var hexDeltas = [...]Hex{
+	East:      {Q: 1, R: 0},
+	Northeast: {Q: 1, R: -1},
+	Northwest: {Q: 0, R: -1},
+	West:      {Q: -1, R: 0},
+	Southwest: {Q: -1, R: 1},
+	Southeast: {Q: 0, R: 1},
+}
+
+// HexWithDirection is a cell of a hexagonal grid and a heading
+type HexWithDirection struct {
+	Hex
+	HexDirection
+}
+
+// S returns the third cube coordinate of h
+func (h Hex) S() int {
+	return -h.Q - h.R
+}
+
+// Add returns h moved by o
+func (h Hex) Add(o Hex) Hex {
+	return Hex{Q: h.Q + o.Q, R: h.R + o.R}
+}
+
+// Step returns the cell n cells away from h in direction d
+func (h Hex) Step(d HexDirection, n int) Hex {
+	o := d.Delta()
+	return Hex{Q: h.Q + o.Q*n, R: h.R + o.R*n}
+}
+
+// Neighbours returns the six cells next to h, in the order of
+// HexDirections
+func (h Hex) Neighbours() [6]Hex {
+
+	var n [6]Hex
+
+	for i, d := range hexDirections {
+		n[i] = h.Add(d.Delta())
+	}
+
+	return n
+}
+
+// Distance returns the number of steps between h and o
+func (h Hex) Distance(o Hex) int {
+	return (abs(h.Q-o.Q) + abs(h.R-o.R) + abs(h.S()-o.S())) / 2
+}
+
+// Delta returns the change of coordinates of one step towards d
+func (d HexDirection) Delta() Hex {
+
+	if d < 0 || int(d) >= len(hexDeltas) {
+		return Hex{}
+	}
+
+	return hexDeltas[d]
+}
+
+// Opposite returns the side facing d
+func (d HexDirection) Opposite() HexDirection {
+	return (d + 3) % 6
+}
+
+func (d HexDirection) String() string {
+	return [...]string{"East", "Northeast", "Northwest", "West", "Southwest", "Southeast"}[d]
+}
+
+// HexBoard is an unbounded hexagonal grid backed by a map, tracking
+// visits and paths like Board. Cells never set hold the zero value
+type HexBoard[T any] struct {
+	pos    Hex
+	cells  map[Hex]T
+	visits map[Hex]int
+	paths  map[HexWithDirection]int
+}
+
+// NewHexBoard returns an empty hexagonal grid
+func NewHexBoard[T any]() *HexBoard[T] {
+	return &HexBoard[T]{
+		cells:  make(map[Hex]T),
+		visits: make(map[Hex]int),
+		paths:  make(map[HexWithDirection]int),
+	}
+}
+
+func (b *HexBoard[T]) Get(h Hex) T {
+	return b.cells[h]
+}
+
+func (b *HexBoard[T]) Set(h Hex, v T) {
+	b.cells[h] = v
+}
+
+// Cells returns the cells that have been set
+func (b *HexBoard[T]) Cells() []Hex {
+
+	cells := make([]Hex, 0, len(b.cells))
+
+	for h := range b.cells {
+		cells = append(cells, h)
+	}
+
+	return cells
+}
+
+func (b *HexBoard[T]) Visit(h Hex) {
+	b.visits[h]++
+	b.pos = h
+}
+
+// VisitPath visits a cell and increments the path count for the
+// given direction
+func (b *HexBoard[T]) VisitPath(h HexWithDirection) {
+	b.paths[h]++
+	b.Visit(h.Hex)
+}
+
+func (b *HexBoard[T]) GetVisits(h Hex) int {
+	return b.visits[h]
+}
+
+func (b *HexBoard[T]) ResetVisits() {
+	b.visits = make(map[Hex]int)
+}
+
+func (b *HexBoard[T]) GetPaths(h HexWithDirection) int {
+	return b.paths[h]
+}
+
+func (b *HexBoard[T]) ResetPaths() {
+	b.paths = make(map[HexWithDirection]int)
+}
+
+func (b *HexBoard[T]) GetVisited() []Hex {
+
+	visited := make([]Hex, 0, len(b.visits))
+
+	for h := range b.visits {
+		visited = append(visited, h)
+	}
+
+	return visited
+}
+
+func (b *HexBoard[T]) GetPosition() Hex {
+	return b.pos
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHexDistance(t *testing.T) {
+
+	// directions of a flat-top layout
+	flat := map[string]HexDirection{
+		"n":  Northwest,
+		"ne": Northeast,
+		"se": East,
+		"s":  Southeast,
+		"sw": Southwest,
+		"nw": West,
+	}
+
+	type test struct {
+		input string
+		want  int
+	}
+
+	tests := []test{
+		{input: "ne,ne,ne", want: 3},
+		{input: "ne,ne,sw,sw", want: 0},
+		{input: "ne,ne,s,s", want: 2},
+		{input: "se,sw,se,sw,sw", want: 3},
+	}
+
+	for _, test := range tests {
+
+		b := NewHexBoard[rune]()
+
+		var h Hex
+
+		for _, s := range strings.Split(test.input, ",") {
+			h = h.Step(flat[s], 1)
+			b.VisitPath(HexWithDirection{h, flat[s]})
+		}
+
+		if got := h.Distance(Hex{}); got != test.want {
+			t.Errorf("got %v, want %v for %v", got, test.want, test.input)
+		}
+
+		if got := b.GetPosition(); got != h {
+			t.Errorf("got %v, want %v for %v", got, h, test.input)
+		}
+	}
+}
+
+func TestHexNeighbours(t *testing.T) {
+
+	h := Hex{Q: 2, R: -1}
+
+	for i, n := range h.Neighbours() {
+
+		if got := h.Distance(n); got != 1 {
+			t.Errorf("got %v, want %v for %v", got, 1, n)
+		}
+
+		if got := n.Add(HexDirections()[i].Opposite().Delta()); got != h {
+			t.Errorf("got %v, want %v going back from %v", got, h, n)
+		}
+	}
+
+	if got := h.Q + h.R + h.S(); got != 0 {
+		t.Errorf("got %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const historyFile = "answers.json"
+
+var (
+	// Errors
+	ErrKnownWrong = errors.New("answer already rejected")
+	ErrOutOfRange = errors.New("answer outside known bounds")
+	ErrSolved     = errors.New("part already solved")
+)
+
+// Attempt is a submitted answer and its outcome
+type Attempt struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    Part      `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// History keeps every answer submitted with a session
+type History struct {
+	path     string
+	Attempts []Attempt `json:"attempts"`
+}
+
+// HistoryPath returns the location of the answer history for year
+func (c *Cache) HistoryPath(year int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), c.Account, historyFile)
+}
+
+// LoadHistory reads the history stored at path. A missing file
+// is an empty history
+func LoadHistory(path string) (*History, error) {
+
+	h := &History{
+		path: path,
+	}
+
+	b, err := os.ReadFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read history: %v", err)
+	}
+
+	if err := json.Unmarshal(b, h); err != nil {
+		return nil, fmt.Errorf("could not parse history: %v", err)
+	}
+
+	return h, nil
+}
+
+// Record appends an attempt and saves the history
+func (h *History) Record(a Attempt) error {
+
+	h.Attempts = append(h.Attempts, a)
+
+	b, err := json.MarshalIndent(h, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(h.path, b, 0600)
+}
+
+// Find returns the attempts for the given puzzle part, oldest first
+func (h *History) Find(year, day int, p Part) []Attempt {
+
+	var found []Attempt
+
+	for _, a := range h.Attempts {
+		if a.Year == year && a.Day == day && a.Part == p {
+			found = append(found, a)
+		}
+	}
+
+	return found
+}
+
+// Check returns an error if answer is known not to be correct
+// from previous attempts, so it is not worth submitting
+func (h *History) Check(year, day int, p Part, answer string) error {
+
+	n, numeric := new(big.Int).SetString(answer, 10)
+
+	for _, a := range h.Find(year, day, p) {
+
+		switch a.Outcome {
+		case Correct:
+			return fmt.Errorf("%w with %v", ErrSolved, a.Answer)
+
+		case Wrong, TooHigh, TooLow:
+			if a.Answer == answer {
+				return fmt.Errorf("%w: %v was %v", ErrKnownWrong, answer, a.Outcome)
+			}
+		}
+
+		if !numeric || (a.Outcome != TooHigh && a.Outcome != TooLow) {
+			continue
+		}
+
+		bound, ok := new(big.Int).SetString(a.Answer, 10)
+
+		if !ok {
+			continue
+		}
+
+		if a.Outcome == TooHigh && n.Cmp(bound) >= 0 {
+			return fmt.Errorf("%w: %v is too high, %v already was", ErrOutOfRange, answer, a.Answer)
+		}
+
+		if a.Outcome == TooLow && n.Cmp(bound) <= 0 {
+			return fmt.Errorf("%w: %v is too low, %v already was", ErrOutOfRange, answer, a.Answer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestHistoryCheck(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "answers.json")
+
+	h, err := LoadHistory(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range []Attempt{
+		{Year: 2024, Day: 6, Part: Part1, Answer: "100", Outcome: TooHigh},
+		{Year: 2024, Day: 6, Part: Part1, Answer: "10", Outcome: TooLow},
+		{Year: 2024, Day: 6, Part: Part1, Answer: "50", Outcome: Wrong},
+		{Year: 2024, Day: 6, Part: Part2, Answer: "7", Outcome: Correct},
+	} {
+		if err := h.Record(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// reload to check the history survives a round trip
+	h, err = LoadHistory(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type test struct {
+		p      Part
+		answer string
+		err    error
+	}
+
+	tests := []test{
+		{Part1, "100", ErrKnownWrong},
+		{Part1, "50", ErrKnownWrong},
+		{Part1, "101", ErrOutOfRange},
+		{Part1, "9", ErrOutOfRange},
+		{Part1, "10", ErrKnownWrong},
+		{Part1, "42", nil},
+		{Part1, "abc", nil},
+		{Part2, "7", ErrSolved},
+		{Part2, "8", ErrSolved},
+	}
+
+	for _, test := range tests {
+
+		err := h.Check(2024, 6, test.p, test.answer)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("got %v, want %v for part %v answer %v", err, test.err, test.p, test.answer)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package common
+
+import "iter"
+
+// All yields every cell of the board with its position, in reading
+// order
+func (b *Board[T]) All() iter.Seq2[Position, T] {
+	return func(yield func(Position, T) bool) {
+
+		d := b.GetDimension()
+
+		for y := 0; y < d.N; y++ {
+			for x := 0; x < d.M; x++ {
+
+				p := Position{X: x, Y: y}
+
+				v, _ := b.Get(p)
+
+				if !yield(p, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Find yields the positions of the cells accepted by match, in
+// reading order
+func (b *Board[T]) Find(match func(v T) bool) iter.Seq2[Position, T] {
+	return func(yield func(Position, T) bool) {
+		for p, v := range b.All() {
+			if match(v) && !yield(p, v) {
+				return
+			}
+		}
+	}
+}
+
+// Positions yields the positions of the cells holding v, in reading
+// order
+func Positions[T comparable](b *Board[T], v T) iter.Seq[Position] {
+	return func(yield func(Position) bool) {
+		for p := range b.Find(func(w T) bool { return w == v }) {
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// Ray yields p and every cell after it in direction d, until the
+// edge of the board
+func (b *Board[T]) Ray(p Position, d Direction) iter.Seq2[Position, T] {
+	return func(yield func(Position, T) bool) {
+
+		o := d.Delta()
+
+		if o == (Position{}) {
+			return
+		}
+
+		for dim := b.GetDimension(); CheckPos(dim, p); p = p.Add(o) {
+
+			v, _ := b.Get(p)
+
+			if !yield(p, v) {
+				return
+			}
+		}
+	}
+}
+
+// Lines yields every line of cells running in direction d, each one
+// from its first cell to the edge. Lines start in reading order
+func (b *Board[T]) Lines(d Direction) iter.Seq[iter.Seq2[Position, T]] {
+	return func(yield func(iter.Seq2[Position, T]) bool) {
+
+		o := d.Delta()
+
+		if o == (Position{}) {
+			return
+		}
+
+		dim := b.GetDimension()
+
+		for p := range b.All() {
+
+			// lines start where the previous cell is off the board
+			if CheckPos(dim, p.Sub(o)) {
+				continue
+			}
+
+			if !yield(b.Ray(p, d)) {
+				return
+			}
+		}
+	}
+}
+
+// Row yields the cells of row y from left to right
+func (b *Board[T]) Row(y int) iter.Seq2[Position, T] {
+	return b.Ray(Position{X: 0, Y: y}, Right)
+}
+
+// Column yields the cells of column x from top to bottom
+func (b *Board[T]) Column(x int) iter.Seq2[Position, T] {
+	return b.Ray(Position{X: x, Y: 0}, Down)
+}
+
+// Rows yields every row from top to bottom
+func (b *Board[T]) Rows() iter.Seq[iter.Seq2[Position, T]] {
+	return b.Lines(Right)
+}
+
+// Columns yields every column from left to right
+func (b *Board[T]) Columns() iter.Seq[iter.Seq2[Position, T]] {
+	return b.Lines(Down)
+}
+
+// Diagonals yields every diagonal running down and right, then
+// every one running down and left
+func (b *Board[T]) Diagonals() iter.Seq[iter.Seq2[Position, T]] {
+	return func(yield func(iter.Seq2[Position, T]) bool) {
+		for _, d := range []Direction{Downright, Downleft} {
+			for line := range b.Lines(d) {
+				if !yield(line) {
+					return
+				}
+			}
+		}
+	}
+}
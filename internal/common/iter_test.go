@@ -0,0 +1,104 @@
+package common
+
+import (
+	"iter"
+	"testing"
+)
+
+func TestIterators(t *testing.T) {
+
+	b := ParseRune([]string{
+		"abc",
+		"def",
+	})
+
+	line := func(seq iter.Seq2[Position, rune]) string {
+
+		var s []rune
+
+		for _, v := range seq {
+			s = append(s, v)
+		}
+
+		return string(s)
+	}
+
+	lines := func(seq iter.Seq[iter.Seq2[Position, rune]]) []string {
+
+		var s []string
+
+		for l := range seq {
+			s = append(s, line(l))
+		}
+
+		return s
+	}
+
+	type test struct {
+		name string
+		got  []string
+		want []string
+	}
+
+	tests := []test{
+		{name: "all", got: []string{line(b.All())}, want: []string{"abcdef"}},
+		{name: "row", got: []string{line(b.Row(1))}, want: []string{"def"}},
+		{name: "column", got: []string{line(b.Column(2))}, want: []string{"cf"}},
+		{name: "rows", got: lines(b.Rows()), want: []string{"abc", "def"}},
+		{name: "columns", got: lines(b.Columns()), want: []string{"ad", "be", "cf"}},
+		{name: "diagonals", got: lines(b.Diagonals()), want: []string{"ae", "bf", "c", "d", "a", "bd", "ce", "f"}},
+		{name: "lines up", got: lines(b.Lines(Up)), want: []string{"da", "eb", "fc"}},
+		{name: "ray", got: []string{line(b.Ray(Position{X: 2, Y: 1}, Left))}, want: []string{"fed"}},
+		{name: "ray out of bounds", got: []string{line(b.Ray(Position{X: 3, Y: 1}, Left))}, want: []string{""}},
+		{name: "find", got: []string{line(b.Find(func(r rune) bool { return r > 'c' }))}, want: []string{"def"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			if len(test.got) != len(test.want) {
+				t.Fatalf("got %q, want %q", test.got, test.want)
+			}
+
+			for i := range test.got {
+				if test.got[i] != test.want[i] {
+					t.Errorf("got %q, want %q", test.got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPositions(t *testing.T) {
+
+	b := ParseRune([]string{
+		"#.#",
+		".#.",
+	})
+
+	want := []Position{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 1}}
+
+	var got []Position
+
+	for p := range Positions(&b, '#') {
+		got = append(got, p)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+
+	// stopping early
+	for p := range Positions(&b, '#') {
+		if p != want[0] {
+			t.Errorf("got %v, want %v", p, want[0])
+		}
+		break
+	}
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"context"
+	"runtime"
+	"time"
+)
+
+// Measurement is the outcome of solving a part along with the
+// resources it took
+type Measurement struct {
+	Answer   Answer
+	Err      error
+	Duration time.Duration
+	Allocs   uint64 // heap objects allocated
+	Bytes    uint64 // heap bytes allocated
+}
+
+// Measure solves part p of input s, recording wall time and heap
+// allocations. Allocations made by other goroutines meanwhile are
+// counted too
+func Measure(ctx context.Context, solve Solver, s []string, p Part) Measurement {
+
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	answer, err := solve(ctx, s, p)
+
+	d := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return Measurement{
+		Answer:   answer,
+		Err:      err,
+		Duration: d,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+	}
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"context"
+	"testing"
+)
+
+var sink [][]byte
+
+func TestMeasure(t *testing.T) {
+
+	solve := func(ctx context.Context, s []string, p Part) (Answer, error) {
+
+		for i := 0; i < 100; i++ {
+			sink = append(sink, make([]byte, 1024))
+		}
+
+		return Int(len(s)), nil
+	}
+
+	m := Measure(context.Background(), solve, []string{"a", "b"}, Part1)
+
+	if m.Err != nil {
+		t.Fatal(m.Err)
+	}
+
+	if !m.Answer.Equal(Int(2)) {
+		t.Errorf("got %v, want 2", m.Answer)
+	}
+
+	if m.Allocs < 100 {
+		t.Errorf("got %v allocs, want at least 100", m.Allocs)
+	}
+
+	if m.Bytes < 100*1024 {
+		t.Errorf("got %v bytes, want at least %v", m.Bytes, 100*1024)
+	}
+
+	if m.Duration <= 0 {
+		t.Errorf("got %v, want a positive duration", m.Duration)
+	}
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+var (
+	// Errors
+	ErrRaggedBoard = errors.New("rows have different lengths")
+)
+
+// newBoard returns a Board over grid starting at position 0, 0
+// with no visits
+func newBoard[T any](grid [][]T) Board[T] {
+
+	b := Board[T]{
+		pos:    Position{0, 0},
+		visits: make(map[Position]int),
+		paths:  make(map[PositionWithDirection]int),
+	}
+
+	b.setGrid(grid)
+
+	return b
+}
+
+// Parse returns a Board with one cell per rune of s, converted with
+// f. Empty lines before and after the grid are ignored, but every
+// row in between must have the same length
+func Parse[T any](s []string, f func(rune) (T, error)) (Board[T], error) {
+
+	start, end := 0, len(s)
+
+	for start < end && len(s[start]) == 0 {
+		start++
+	}
+
+	for end > start && len(s[end-1]) == 0 {
+		end--
+	}
+
+	grid := make([][]T, 0, end-start)
+
+	for i, line := range s[start:end] {
+
+		row := make([]T, 0, len(line))
+
+		// positions in errors count lines of s and runes from 0
+		for _, r := range line {
+
+			v, err := f(r)
+
+			if err != nil {
+				return Board[T]{}, fmt.Errorf("line %v column %v: %w", start+i, len(row), err)
+			}
+
+			row = append(row, v)
+		}
+
+		if len(grid) > 0 && len(row) != len(grid[0]) {
+			return Board[T]{}, fmt.Errorf("%w: line %v has %v cells, want %v", ErrRaggedBoard, start+i, len(row), len(grid[0]))
+		}
+
+		grid = append(grid, row)
+	}
+
+	b := newBoard(grid)
+
+	slog.Debug("Parsed board", "board size", b.GetDimension())
+
+	return b, nil
+}
+
+// ParseReader is like Parse, reading the lines from r
+func ParseReader[T any](r io.Reader, f func(rune) (T, error)) (Board[T], error) {
+
+	var s []string
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		s = append(s, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Board[T]{}, err
+	}
+
+	return Parse(s, f)
+}
+
+// ParseDigits returns a Board of single digit numbers, such as
+// a height map
+func ParseDigits(s []string) (Board[int], error) {
+	return Parse(s, func(r rune) (int, error) {
+
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("%w: %q is not a digit", ErrInvalidInput, r)
+		}
+
+		return int(r - '0'), nil
+	})
+}
+
+// ParseMask returns a Board which is true where s has the rune on,
+// such as the walls of a maze
+func ParseMask(s []string, on rune) (Board[bool], error) {
+	return Parse(s, func(r rune) (bool, error) {
+		return r == on, nil
+	})
+}
+
+// Runes is the identity conversion for Parse, keeping every rune
+func Runes(r rune) (rune, error) {
+	return r, nil
+}
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	type test struct {
+		name  string
+		input []string
+		grid  [][]rune
+		err   error
+	}
+
+	tests := []test{
+		{
+			name:  "square",
+			input: []string{"AB", "CD"},
+			grid:  [][]rune{{'A', 'B'}, {'C', 'D'}},
+		},
+		{
+			name:  "surrounding empty lines",
+			input: []string{"", "AB", "CD", "", ""},
+			grid:  [][]rune{{'A', 'B'}, {'C', 'D'}},
+		},
+		{
+			name:  "empty",
+			input: []string{"", ""},
+			grid:  [][]rune{},
+		},
+		{
+			name:  "ragged",
+			input: []string{"AB", "C"},
+			err:   ErrRaggedBoard,
+		},
+		{
+			name:  "empty line mid input",
+			input: []string{"AB", "", "CD"},
+			err:   ErrRaggedBoard,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			b, err := Parse(test.input, Runes)
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got %v, want %v", err, test.err)
+			}
+
+			if err == nil && !reflect.DeepEqual(b.grid, test.grid) {
+				t.Errorf("got %v, want %v", b.grid, test.grid)
+			}
+		})
+	}
+
+	// errors count the skipped lines, and runes rather than bytes
+	noX := func(r rune) (rune, error) {
+
+		if r == 'x' {
+			return 0, ErrInvalidInput
+		}
+
+		return r, nil
+	}
+
+	type position struct {
+		input []string
+		want  string
+	}
+
+	for _, test := range []position{
+		{input: []string{"", "ab", "éx"}, want: "line 2 column 1"},
+		{input: []string{"", "ab", "é"}, want: "line 2 has 1 cells"},
+	} {
+		if _, err := Parse(test.input, noX); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("got %v, want an error at %v", err, test.want)
+		}
+	}
+}
+
+func TestParseDigits(t *testing.T) {
+
+	b, err := ParseDigits([]string{"0123", "4567"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}}
+
+	if !reflect.DeepEqual(b.grid, want) {
+		t.Errorf("got %v, want %v", b.grid, want)
+	}
+
+	if _, err := ParseDigits([]string{"01", "2x"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("got %v, want %v", err, ErrInvalidInput)
+	}
+}
+
+func TestParseMask(t *testing.T) {
+
+	b, err := ParseMask([]string{"#.", ".#"}, '#')
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]bool{{true, false}, {false, true}}
+
+	if !reflect.DeepEqual(b.grid, want) {
+		t.Errorf("got %v, want %v", b.grid, want)
+	}
+}
+
+func TestParseReader(t *testing.T) {
+
+	b, err := ParseReader(strings.NewReader("AB\nCD\n"), Runes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d := b.GetDimension(); d != (Dimension{2, 2}) {
+		t.Errorf("got %v, want 2x2", d)
+	}
+
+	// visits work on parsed boards
+	if err := b.Visit(Position{1, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ParseReader(strings.NewReader("AB\nC\n"), Runes); !errors.Is(err, ErrRaggedBoard) {
+		t.Errorf("got %v, want %v", err, ErrRaggedBoard)
+	}
+}
@@ -0,0 +1,22 @@
+package common
+
+import "context"
+
+// ProgressFunc receives how much of a long running loop is done
+type ProgressFunc func(done, total int)
+
+type progressKey struct{}
+
+// WithProgress returns a context carrying f, which solvers call
+// through ReportProgress
+func WithProgress(ctx context.Context, f ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, f)
+}
+
+// ReportProgress tells the ProgressFunc carried by ctx, if any,
+// that done out of total steps have been completed
+func ReportProgress(ctx context.Context, done, total int) {
+	if f, ok := ctx.Value(progressKey{}).(ProgressFunc); ok {
+		f(done, total)
+	}
+}
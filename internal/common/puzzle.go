@@ -0,0 +1,301 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	// Errors
+	ErrNoDescription = errors.New("no puzzle description found")
+)
+
+// Puzzle is the description of a day as published on the website
+type Puzzle struct {
+	Title    string
+	Markdown string
+	Examples []Example
+}
+
+// Example is the first example input given for a part, along with
+// the answer the description highlights for it, if any
+type Example struct {
+	Part  Part
+	Input []string
+	Want  string
+}
+
+// node is a minimal HTML element tree
+type node struct {
+	tag      string
+	attr     map[string]string
+	text     string // only set for text nodes
+	children []*node
+}
+
+// Puzzle returns the HTML description page for the given year and day
+func (c *Client) Puzzle(ctx context.Context, year, day int) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, fmt.Sprintf("/%v/day/%v", year, day), nil)
+}
+
+// ParsePuzzle extracts the description and examples from a puzzle
+// page. Each <article class="day-desc"> is one part of the puzzle
+func ParsePuzzle(page []byte) (Puzzle, error) {
+
+	// only the main element is parsed, scripts and styles
+	// elsewhere on the page are not always valid XML
+	if i := bytes.Index(page, []byte("<main")); i >= 0 {
+		page = page[i:]
+	}
+
+	if i := bytes.LastIndex(page, []byte("</main>")); i >= 0 {
+		page = page[:i+len("</main>")]
+	}
+
+	root, err := parseHTML(bytes.NewReader(page))
+
+	if err != nil {
+		return Puzzle{}, err
+	}
+
+	var articles []*node
+
+	root.walk(func(n *node) bool {
+		if n.tag == "article" && strings.Contains(n.attr["class"], "day-desc") {
+			articles = append(articles, n)
+			return false
+		}
+		return true
+	})
+
+	if len(articles) == 0 {
+		return Puzzle{}, ErrNoDescription
+	}
+
+	var p Puzzle
+	var md strings.Builder
+
+	for i, a := range articles {
+
+		part := Part(i + 1)
+
+		if i == 0 {
+			if h := a.find("h2"); h != nil {
+				p.Title = strings.Trim(h.textContent(), "- ")
+			}
+		}
+
+		renderMarkdown(&md, a)
+
+		e := Example{
+			Part: part,
+			Want: lastHighlight(a),
+		}
+
+		if pre := a.find("pre"); pre != nil {
+			e.Input = strings.Split(strings.TrimRight(pre.textContent(), "\n"), "\n")
+		} else if len(p.Examples) > 0 {
+			// later parts usually reuse the first example
+			e.Input = p.Examples[0].Input
+		}
+
+		if e.Input != nil {
+			p.Examples = append(p.Examples, e)
+		}
+	}
+
+	p.Markdown = strings.TrimSpace(md.String()) + "\n"
+
+	return p, nil
+}
+
+// parseHTML builds a node tree out of loosely formed HTML
+func parseHTML(r io.Reader) (*node, error) {
+
+	d := xml.NewDecoder(r)
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	root := &node{}
+	stack := []*node{root}
+
+	for {
+		t, err := d.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse puzzle: %v", err)
+		}
+
+		top := stack[len(stack)-1]
+
+		switch t := t.(type) {
+		case xml.StartElement:
+			n := &node{
+				tag:  strings.ToLower(t.Name.Local),
+				attr: make(map[string]string),
+			}
+
+			for _, a := range t.Attr {
+				n.attr[strings.ToLower(a.Name.Local)] = a.Value
+			}
+
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+
+		case xml.EndElement:
+			// close up to the matching element, tolerating
+			// unbalanced tags
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == strings.ToLower(t.Name.Local) {
+					stack = stack[:i]
+					break
+				}
+			}
+
+		case xml.CharData:
+			top.children = append(top.children, &node{text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+// walk visits n and its descendants depth first, skipping the
+// children of nodes for which f returns false
+func (n *node) walk(f func(*node) bool) {
+
+	if !f(n) {
+		return
+	}
+
+	for _, c := range n.children {
+		c.walk(f)
+	}
+}
+
+// find returns the first descendant with the given tag
+func (n *node) find(tag string) *node {
+
+	var found *node
+
+	n.walk(func(c *node) bool {
+		if found == nil && c.tag == tag {
+			found = c
+		}
+		return found == nil
+	})
+
+	return found
+}
+
+func (n *node) textContent() string {
+
+	var b strings.Builder
+
+	n.walk(func(c *node) bool {
+		b.WriteString(c.text)
+		return true
+	})
+
+	return b.String()
+}
+
+// lastHighlight returns the last <code><em> of an article, which
+// is where descriptions state the answer for their example
+func lastHighlight(a *node) string {
+
+	var want string
+
+	a.walk(func(n *node) bool {
+		if n.tag == "code" {
+			if em := n.find("em"); em != nil {
+				want = strings.TrimSpace(em.textContent())
+			}
+			return false
+		}
+		return true
+	})
+
+	return want
+}
+
+func renderMarkdown(b *strings.Builder, n *node) {
+
+	if n.tag == "" && n.text != "" {
+		b.WriteString(n.text)
+		return
+	}
+
+	switch n.tag {
+	case "h2":
+		b.WriteString("## ")
+		renderChildren(b, n)
+		b.WriteString("\n\n")
+
+	case "p":
+		renderChildren(b, n)
+		b.WriteString("\n\n")
+
+	case "pre":
+		b.WriteString("```\n")
+		b.WriteString(strings.TrimRight(n.textContent(), "\n"))
+		b.WriteString("\n```\n\n")
+
+	case "code":
+		if n.find("em") != nil {
+			fmt.Fprintf(b, "**`%v`**", n.textContent())
+			return
+		}
+		fmt.Fprintf(b, "`%v`", n.textContent())
+
+	case "em":
+		b.WriteString("**")
+		renderChildren(b, n)
+		b.WriteString("**")
+
+	case "a":
+		b.WriteString("[")
+		renderChildren(b, n)
+		href := n.attr["href"]
+		if strings.HasPrefix(href, "/") {
+			href = DefaultBaseURL + href
+		}
+		fmt.Fprintf(b, "](%v)", href)
+
+	case "ul":
+		renderChildren(b, n)
+		b.WriteString("\n")
+
+	case "li":
+		b.WriteString("- ")
+		renderChildren(b, n)
+		b.WriteString("\n")
+
+	default:
+		renderChildren(b, n)
+	}
+}
+
+func renderChildren(b *strings.Builder, n *node) {
+
+	for _, c := range n.children {
+
+		// whitespace between block elements
+		if c.tag == "" && strings.TrimSpace(c.text) == "" && n.tag != "p" && n.tag != "li" {
+			continue
+		}
+
+		renderMarkdown(b, c)
+	}
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePuzzle(t *testing.T) {
+
+	type test struct {
+		fixture  string
+		title    string
+		examples []Example
+		markdown []string
+	}
+
+	tests := []test{
+		{
+			fixture: "testdata/day3.html",
+			title:   "Day 3: Mull It Over",
+			examples: []Example{
+				{
+					Part:  Part1,
+					Input: []string{"xmul(2,4)%&mul[3,7]!@^do_not_mul(5,5)+mul(32,64]then(mul(11,8)mul(8,5))"},
+					Want:  "161",
+				},
+				{
+					Part:  Part2,
+					Input: []string{"xmul(2,4)&mul[3,7]!^don't()_mul(5,5)+mul(32,64](mul(11,8)undo()?mul(8,5))"},
+					Want:  "48",
+				},
+			},
+			markdown: []string{
+				"## --- Day 3: Mull It Over ---\n\n",
+				"## --- Part Two ---\n\n",
+				"[North Pole Toboggan Rental Shop](https://adventofcode.com/2020/day/2)",
+				"produces **`161`** (`2*4 + 5*5 + 11*8 + 8*5`).",
+				"- The `do()` instruction **enables** future `mul` instructions.\n",
+				"```\nxmul(2,4)&mul[3,7]!^don't()",
+			},
+		},
+		{
+			fixture: "testdata/day6.html",
+			title:   "Day 6: Guard Gallivant",
+			examples: []Example{
+				{
+					Part: Part1,
+					Input: []string{
+						"....#.....",
+						".........#",
+						"..........",
+						"..#.......",
+						".......#..",
+						"..........",
+						".#..^.....",
+						"........#.",
+						"#.........",
+						"......#...",
+					},
+					Want: "41",
+				},
+			},
+			markdown: []string{
+				"```\n....#.....\n.........#\n",
+				"#.........\n......#...\n```\n",
+				"visit **`41`** distinct positions",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+
+			page, err := os.ReadFile(test.fixture)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p, err := ParsePuzzle(page)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if p.Title != test.title {
+				t.Errorf("got %q, want %q", p.Title, test.title)
+			}
+
+			if !reflect.DeepEqual(p.Examples, test.examples) {
+				t.Errorf("got %q, want %q", p.Examples, test.examples)
+			}
+
+			for _, m := range test.markdown {
+				if !strings.Contains(p.Markdown, m) {
+					t.Errorf("markdown does not contain %q:\n%v", m, p.Markdown)
+				}
+			}
+
+			if strings.Contains(p.Markdown, "<") {
+				t.Errorf("markdown contains HTML:\n%v", p.Markdown)
+			}
+		})
+	}
+}
+
+func TestParsePuzzleNoDescription(t *testing.T) {
+
+	if _, err := ParsePuzzle([]byte("<main><p>nothing here</p></main>")); err != ErrNoDescription {
+		t.Errorf("got %v, want %v", err, ErrNoDescription)
+	}
+}
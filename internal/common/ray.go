@@ -0,0 +1,86 @@
+package common
+
+import (
+	"iter"
+	"strings"
+)
+
+// Cast yields the cells after p in direction d until the edge of the
+// board or, when stop is not nil, until a cell accepted by stop,
+// which is not yielded
+func (b *Board[T]) Cast(p Position, d Direction, stop func(p Position, v T) bool) iter.Seq2[Position, T] {
+	return func(yield func(Position, T) bool) {
+
+		o := d.Delta()
+
+		if o == (Position{}) {
+			return
+		}
+
+		for q, v := range b.Ray(p.Add(o), d) {
+			if stop != nil && stop(q, v) || !yield(q, v) {
+				return
+			}
+		}
+	}
+}
+
+// Hit returns the first cell after p in direction d accepted by stop,
+// and false if the ray leaves the board before reaching one
+func (b *Board[T]) Hit(p Position, d Direction, stop func(p Position, v T) bool) (Position, T, bool) {
+
+	for q, v := range b.Ray(p.Add(d.Delta()), d) {
+		if stop(q, v) {
+			return q, v, true
+		}
+	}
+
+	var zero T
+
+	return Position{}, zero, false
+}
+
+// ReadRay returns the runes along the ray from p in direction d, p
+// included, stopping after n of them or at the edge of the board
+// when n is negative
+func ReadRay(b *Board[rune], p Position, d Direction, n int) string {
+
+	var sb strings.Builder
+
+	for _, r := range b.Ray(p, d) {
+
+		if n == 0 {
+			break
+		}
+
+		sb.WriteRune(r)
+		n--
+	}
+
+	return sb.String()
+}
+
+// MatchRay reports whether word is read along the ray from p in
+// direction d, p included. Unlike ReadRay it stops at the first
+// mismatch and does not allocate. Nothing is read without a direction
+func MatchRay(b *Board[rune], p Position, d Direction, word string) bool {
+
+	o := d.Delta()
+
+	if o == (Position{}) {
+		return false
+	}
+
+	for _, w := range word {
+
+		r, err := b.Get(p)
+
+		if err != nil || r != w {
+			return false
+		}
+
+		p = p.Add(o)
+	}
+
+	return true
+}
@@ -0,0 +1,183 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestCast(t *testing.T) {
+
+	b := ParseRune([]string{
+		"..#..",
+		".....",
+		"^...#",
+	})
+
+	wall := func(_ Position, v rune) bool { return v == '#' }
+
+	type test struct {
+		name string
+		p    Position
+		d    Direction
+		stop func(Position, rune) bool
+		want []Position
+	}
+
+	tests := []test{
+		{
+			name: "until a wall",
+			p:    Position{X: 0, Y: 2},
+			d:    Right,
+			stop: wall,
+			want: []Position{{X: 1, Y: 2}, {X: 2, Y: 2}, {X: 3, Y: 2}},
+		},
+		{
+			name: "until the edge",
+			p:    Position{X: 0, Y: 2},
+			d:    Up,
+			stop: wall,
+			want: []Position{{X: 0, Y: 1}, {X: 0, Y: 0}},
+		},
+		{
+			name: "next to a wall",
+			p:    Position{X: 2, Y: 1},
+			d:    Up,
+			stop: wall,
+			want: nil,
+		},
+		{
+			name: "without stop",
+			p:    Position{X: 2, Y: 2},
+			d:    Right,
+			want: []Position{{X: 3, Y: 2}, {X: 4, Y: 2}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			var got []Position
+
+			for p := range b.Cast(test.p, test.d, test.stop) {
+				got = append(got, p)
+			}
+
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Errorf("got %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestHit(t *testing.T) {
+
+	b := ParseRune([]string{
+		"..#..",
+		".....",
+		"^...#",
+	})
+
+	wall := func(_ Position, v rune) bool { return v == '#' }
+
+	type test struct {
+		name string
+		p    Position
+		d    Direction
+		want Position
+		ok   bool
+	}
+
+	tests := []test{
+		{name: "hit", p: Position{X: 0, Y: 0}, d: Right, want: Position{X: 2, Y: 0}, ok: true},
+		{name: "hit at the edge", p: Position{X: 1, Y: 2}, d: Right, want: Position{X: 4, Y: 2}, ok: true},
+		{name: "miss at the edge", p: Position{X: 0, Y: 2}, d: Up},
+		{name: "miss from the edge", p: Position{X: 4, Y: 0}, d: Right},
+		{name: "no direction", p: Position{X: 2, Y: 0}, d: Unspecified},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			p, v, ok := b.Hit(test.p, test.d, wall)
+
+			if p != test.want || ok != test.ok {
+				t.Errorf("got %v, %v, want %v, %v", p, ok, test.want, test.ok)
+			}
+
+			if ok && v != '#' {
+				t.Errorf("got %c, want %c", v, '#')
+			}
+		})
+	}
+}
+
+func TestReadRay(t *testing.T) {
+
+	b := ParseRune([]string{
+		"XMAS",
+		"MMXA",
+		"AXAS",
+		"SASX",
+	})
+
+	type test struct {
+		p    Position
+		d    Direction
+		n    int
+		want string
+	}
+
+	tests := []test{
+		{p: Position{X: 0, Y: 0}, d: Right, n: 4, want: "XMAS"},
+		{p: Position{X: 0, Y: 0}, d: Down, n: -1, want: "XMAS"},
+		{p: Position{X: 0, Y: 0}, d: Downright, n: 2, want: "XM"},
+		{p: Position{X: 3, Y: 3}, d: Upleft, n: 10, want: "XAMX"},
+		{p: Position{X: 1, Y: 2}, d: Upright, n: 4, want: "XXS"},
+		{p: Position{X: 4, Y: 0}, d: Left, n: 4, want: ""},
+	}
+
+	for _, test := range tests {
+		if got := ReadRay(&b, test.p, test.d, test.n); got != test.want {
+			t.Errorf("got %q, want %q from %v going %v", got, test.want, test.p, test.d)
+		}
+
+		if test.want != "" && !MatchRay(&b, test.p, test.d, test.want) {
+			t.Errorf("got no match for %q from %v going %v", test.want, test.p, test.d)
+		}
+	}
+}
+
+func TestMatchRay(t *testing.T) {
+
+	b := ParseRune([]string{
+		"XMAS",
+	})
+
+	type test struct {
+		p    Position
+		d    Direction
+		word string
+		want bool
+	}
+
+	tests := []test{
+		{p: Position{X: 0, Y: 0}, d: Right, word: "XMAS", want: true},
+		{p: Position{X: 0, Y: 0}, d: Right, word: "XMASX", want: false},
+		{p: Position{X: 3, Y: 0}, d: Left, word: "SAM", want: true},
+		{p: Position{X: 3, Y: 0}, d: Left, word: "SAX", want: false},
+		{p: Position{X: 5, Y: 0}, d: Left, word: "", want: true},
+		{p: Position{X: 0, Y: 0}, d: Unspecified, word: "X", want: false},
+		{p: Position{X: 0, Y: 0}, d: Unspecified, word: "XX", want: false},
+	}
+
+	for _, test := range tests {
+		if got := MatchRay(&b, test.p, test.d, test.word); got != test.want {
+			t.Errorf("got %v, want %v for %q from %v going %v", got, test.want, test.word, test.p, test.d)
+		}
+	}
+}
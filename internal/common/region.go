@@ -0,0 +1,177 @@
+package common
+
+import "sort"
+
+// Rect is the rectangle between two corners, both included
+type Rect struct {
+	Min, Max Position
+}
+
+// Contains reports whether p lies within r
+func (r Rect) Contains(p Position) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X && p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Dimension returns the number of rows and columns covered by r
+func (r Rect) Dimension() Dimension {
+	return Dimension{N: r.Max.Y - r.Min.Y + 1, M: r.Max.X - r.Min.X + 1}
+}
+
+// Region is a set of connected cells of a board
+type Region struct {
+	Cells []Position // in reading order
+	cells map[Position]bool
+}
+
+// NewRegion returns the region made of the given cells
+func NewRegion(cells []Position) Region {
+
+	r := Region{
+		Cells: append([]Position(nil), cells...),
+		cells: make(map[Position]bool, len(cells)),
+	}
+
+	for _, p := range cells {
+		r.cells[p] = true
+	}
+
+	sort.Slice(r.Cells, func(i, j int) bool {
+
+		if r.Cells[i].Y != r.Cells[j].Y {
+			return r.Cells[i].Y < r.Cells[j].Y
+		}
+
+		return r.Cells[i].X < r.Cells[j].X
+	})
+
+	return r
+}
+
+// Contains reports whether p belongs to r
+func (r Region) Contains(p Position) bool {
+	return r.cells[p]
+}
+
+// Area returns the number of cells of r
+func (r Region) Area() int {
+	return len(r.Cells)
+}
+
+// Perimeter returns the number of cell edges between r and the
+// cells outside of it, holes included
+func (r Region) Perimeter() int {
+
+	var n int
+
+	for _, p := range r.Cells {
+		for _, d := range directions4 {
+			if !r.cells[p.Add(d.Delta())] {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// Sides returns the number of straight sides of the outline of r,
+// holes included. A polygon has as many sides as corners, so it
+// counts those instead
+func (r Region) Sides() int {
+
+	var n int
+
+	for _, p := range r.Cells {
+		// each orthogonal direction and the next one clockwise
+		for _, a := range directions4 {
+
+			b := a.TurnRight()
+
+			inA := r.cells[p.Add(a.Delta())]
+			inB := r.cells[p.Add(b.Delta())]
+			inDiagonal := r.cells[p.Add(a.TurnRight45().Delta())]
+
+			// outer and inner corners
+			if !inA && !inB || inA && inB && !inDiagonal {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// Bounds returns the smallest rectangle containing r
+func (r Region) Bounds() Rect {
+
+	if len(r.Cells) == 0 {
+		return Rect{}
+	}
+
+	b := Rect{Min: r.Cells[0], Max: r.Cells[0]}
+
+	for _, p := range r.Cells[1:] {
+		b.Min.X = min(b.Min.X, p.X)
+		b.Min.Y = min(b.Min.Y, p.Y)
+		b.Max.X = max(b.Max.X, p.X)
+		b.Max.Y = max(b.Max.Y, p.Y)
+	}
+
+	return b
+}
+
+// FloodFill returns the region of cells accepted by in that are
+// connected to start. It is empty if start itself is not accepted
+func (b *Board[T]) FloodFill(start Position, c Connectivity, in func(p Position, v T) bool) Region {
+
+	v, err := b.Get(start)
+
+	if err != nil || !in(start, v) {
+		return NewRegion(nil)
+	}
+
+	r := BFS([]Position{start}, b.Moves(c, func(_, to Position, v T) bool { return in(to, v) }), nil)
+
+	cells := make([]Position, 0, len(r.Dist))
+
+	for p := range r.Dist {
+		cells = append(cells, p)
+	}
+
+	return NewRegion(cells)
+}
+
+// Regions splits the board into connected regions whose cells are
+// the same as the first one found in reading order. same is expected
+// to be an equivalence, such as equality
+func (b *Board[T]) Regions(c Connectivity, same func(a, b T) bool) []Region {
+
+	var regions []Region
+
+	seen := make(map[Position]bool)
+
+	d := b.GetDimension()
+
+	for y := 0; y < d.N; y++ {
+		for x := 0; x < d.M; x++ {
+
+			p := Position{X: x, Y: y}
+
+			if seen[p] {
+				continue
+			}
+
+			seed, _ := b.Get(p)
+
+			r := b.FloodFill(p, c, func(_ Position, v T) bool { return same(seed, v) })
+
+			for _, q := range r.Cells {
+				seen[q] = true
+			}
+
+			regions = append(regions, r)
+		}
+	}
+
+	return regions
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestRegions(t *testing.T) {
+
+	b := ParseRune([]string{
+		"AAAA",
+		"BBCD",
+		"BBCC",
+		"EEEC",
+	})
+
+	type test struct {
+		name      rune
+		area      int
+		perimeter int
+		sides     int
+		bounds    Rect
+	}
+
+	tests := []test{
+		{name: 'A', area: 4, perimeter: 10, sides: 4, bounds: Rect{Position{X: 0, Y: 0}, Position{X: 3, Y: 0}}},
+		{name: 'B', area: 4, perimeter: 8, sides: 4, bounds: Rect{Position{X: 0, Y: 1}, Position{X: 1, Y: 2}}},
+		{name: 'C', area: 4, perimeter: 10, sides: 8, bounds: Rect{Position{X: 2, Y: 1}, Position{X: 3, Y: 3}}},
+		{name: 'D', area: 1, perimeter: 4, sides: 4, bounds: Rect{Position{X: 3, Y: 1}, Position{X: 3, Y: 1}}},
+		{name: 'E', area: 3, perimeter: 8, sides: 4, bounds: Rect{Position{X: 0, Y: 3}, Position{X: 2, Y: 3}}},
+	}
+
+	regions := b.Regions(Connect4, func(a, b rune) bool { return a == b })
+
+	if len(regions) != len(tests) {
+		t.Fatalf("got %v regions, want %v", len(regions), len(tests))
+	}
+
+	for i, test := range tests {
+		t.Run(string(test.name), func(t *testing.T) {
+
+			r := regions[i]
+
+			if v, _ := b.Get(r.Cells[0]); v != test.name {
+				t.Fatalf("got region %c, want %c", v, test.name)
+			}
+
+			if got := r.Area(); got != test.area {
+				t.Errorf("got area %v, want %v", got, test.area)
+			}
+
+			if got := r.Perimeter(); got != test.perimeter {
+				t.Errorf("got perimeter %v, want %v", got, test.perimeter)
+			}
+
+			if got := r.Sides(); got != test.sides {
+				t.Errorf("got %v sides, want %v", got, test.sides)
+			}
+
+			if got := r.Bounds(); got != test.bounds {
+				t.Errorf("got bounds %v, want %v", got, test.bounds)
+			}
+		})
+	}
+}
+
+func TestRegionHoles(t *testing.T) {
+
+	b := ParseRune([]string{
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+	})
+
+	r := b.FloodFill(Position{X: 0, Y: 0}, Connect4, func(_ Position, v rune) bool { return v == 'O' })
+
+	if got := r.Area(); got != 21 {
+		t.Errorf("got area %v, want %v", got, 21)
+	}
+
+	if got := r.Perimeter(); got != 36 {
+		t.Errorf("got perimeter %v, want %v", got, 36)
+	}
+
+	if got := r.Sides(); got != 20 {
+		t.Errorf("got %v sides, want %v", got, 20)
+	}
+
+	if r.Contains(Position{X: 1, Y: 1}) {
+		t.Errorf("got hole %v in region", Position{X: 1, Y: 1})
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+
+	b := ParseRune([]string{
+		"#..",
+		".#.",
+		"..#",
+	})
+
+	open := func(_ Position, v rune) bool { return v == '.' }
+
+	type test struct {
+		name  string
+		start Position
+		c     Connectivity
+		want  int
+	}
+
+	tests := []test{
+		{name: "blocked start", start: Position{X: 0, Y: 0}, c: Connect4, want: 0},
+		{name: "4-connected", start: Position{X: 1, Y: 0}, c: Connect4, want: 3},
+		{name: "8-connected", start: Position{X: 1, Y: 0}, c: Connect8, want: 6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := b.FloodFill(test.start, test.c, open).Area(); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
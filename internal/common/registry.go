@@ -0,0 +1,88 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Solver solves the given part of a puzzle for the input s. Long
+// running solvers stop once ctx is done
+type Solver func(ctx context.Context, s []string, p Part) (Answer, error)
+
+type puzzle struct {
+	year, day int
+}
+
+var (
+	// Errors
+	ErrNotRegistered = errors.New("day not registered")
+
+	registry = make(map[puzzle]Solver)
+)
+
+// Register makes the solver for the day of year available to the
+// runner. It is meant to be called from the init function of each
+// day package and panics if the day is registered twice.
+func Register(year, day int, s Solver) {
+
+	if _, ok := registry[puzzle{year, day}]; ok {
+		panic(fmt.Sprintf("day %v of %v already registered", day, year))
+	}
+
+	registry[puzzle{year, day}] = s
+}
+
+// unregister removes the solver for the day of year, so tests can
+// register their own without leaking it
+func unregister(year, day int) {
+	delete(registry, puzzle{year, day})
+}
+
+// GetSolver returns the solver registered for the day of year
+func GetSolver(year, day int) (Solver, error) {
+
+	s, ok := registry[puzzle{year, day}]
+
+	if !ok {
+		return nil, fmt.Errorf("day %v of %v: %w", day, year, ErrNotRegistered)
+	}
+
+	return s, nil
+}
+
+// Days returns the days registered for year in ascending order
+func Days(year int) []int {
+
+	var days []int
+
+	for p := range registry {
+		if p.year == year {
+			days = append(days, p.day)
+		}
+	}
+
+	sort.Ints(days)
+
+	return days
+}
+
+// Years returns the years with registered days in ascending order
+func Years() []int {
+
+	seen := make(map[int]bool)
+
+	var years []int
+
+	for p := range registry {
+		if !seen[p.year] {
+			seen[p.year] = true
+			years = append(years, p.year)
+		}
+	}
+
+	sort.Ints(years)
+
+	return years
+}
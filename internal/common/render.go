@@ -0,0 +1,152 @@
+package common
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// ANSI escape sequences used when rendering with colors
+const (
+	ansiReset   = "\x1b[0m"
+	ansiInverse = "\x1b[7m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiYellow  = "\x1b[33m"
+)
+
+// RenderOptions configures how a board is rendered
+type RenderOptions[T any] struct {
+	Color    bool           // highlight with ANSI colors
+	Viewport *Rect          // cells to render, the whole board if nil
+	Format   func(T) string // how a cell is shown, see formatCell if nil
+	Override string         // overridden cells without colors, * if empty
+}
+
+// arrows shows the direction a path crossed a cell
+var arrows = map[Direction]string{
+	Up:        "^",
+	Down:      "v",
+	Left:      "<",
+	Right:     ">",
+	Upleft:    "↖",
+	Upright:   "↗",
+	Downleft:  "↙",
+	Downright: "↘",
+}
+
+// Render writes the board to w, see RenderGrid
+func (b *Board[T]) Render(w io.Writer, o RenderOptions[T]) error {
+	return RenderGrid[T](w, b, o)
+}
+
+// RenderGrid writes g to w, one row per line. The current position
+// is shown as @, cells crossed by a path in a single direction as an
+// arrow, or + when crossed in several, and other visited cells as X.
+// Overrides of a Board show their own value in red with colors, and
+// as the Override marker without
+func RenderGrid[T any](w io.Writer, g Grid[T], o RenderOptions[T]) error {
+
+	format := o.Format
+
+	if format == nil {
+		format = formatCell[T]
+	}
+
+	view := g.Bounds()
+
+	if o.Viewport != nil {
+		view.Min.X = max(view.Min.X, o.Viewport.Min.X)
+		view.Min.Y = max(view.Min.Y, o.Viewport.Min.Y)
+		view.Max.X = min(view.Max.X, o.Viewport.Max.X)
+		view.Max.Y = min(view.Max.Y, o.Viewport.Max.Y)
+	}
+
+	marker := o.Override
+
+	if marker == "" {
+		marker = "*"
+	}
+
+	b, _ := g.(*Board[T])
+
+	bw := bufio.NewWriter(w)
+
+	for y := view.Min.Y; y <= view.Max.Y; y++ {
+
+		for x := view.Min.X; x <= view.Max.X; x++ {
+
+			p := Position{X: x, Y: y}
+
+			v, _ := g.Get(p)
+			s, color := format(v), ""
+
+			var overridden bool
+
+			if b != nil {
+				_, overridden = b.overrides[p]
+			}
+
+			dirs := pathDirections(g, p)
+
+			switch {
+			case p == g.GetPosition() && g.GetVisits(p) > 0:
+				s, color = "@", ansiInverse
+			case len(dirs) == 1:
+				s, color = arrows[dirs[0]], ansiYellow
+			case len(dirs) > 1:
+				s, color = "+", ansiYellow
+			case g.GetVisits(p) > 0:
+				s, color = "X", ansiGreen
+			case overridden && o.Color:
+				color = ansiRed
+			case overridden:
+				s = marker
+			}
+
+			if o.Color && color != "" {
+				s = color + s + ansiReset
+			}
+
+			bw.WriteString(s)
+		}
+
+		bw.WriteString("\n")
+	}
+
+	return bw.Flush()
+}
+
+// pathDirections returns the directions p was crossed in, in the
+// order of the Direction constants
+func pathDirections[T any](g Grid[T], p Position) []Direction {
+
+	var dirs []Direction
+
+	for d := Up; d <= Downright; d++ {
+		if g.GetPaths(PositionWithDirection{p, d}) > 0 {
+			dirs = append(dirs, d)
+		}
+	}
+
+	return dirs
+}
+
+// formatCell shows runes and bytes as characters, booleans as # and
+// . and anything else as fmt does
+func formatCell[T any](v T) string {
+
+	switch c := any(v).(type) {
+	case rune:
+		return string(c)
+	case byte:
+		return string(rune(c))
+	case bool:
+		if c {
+			return "#"
+		}
+		return "."
+	}
+
+	return fmt.Sprint(v)
+}
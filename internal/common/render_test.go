@@ -0,0 +1,98 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+
+	board := func() Board[rune] {
+
+		b := ParseRune([]string{
+			"....#",
+			".....",
+			"..#..",
+			".....",
+		})
+
+		b = b.WithOverrides(map[Position]rune{{X: 4, Y: 1}: 'O'})
+
+		b.VisitPath(PositionWithDirection{Position{X: 1, Y: 3}, Up})
+		b.VisitPath(PositionWithDirection{Position{X: 1, Y: 2}, Up})
+		b.VisitPath(PositionWithDirection{Position{X: 1, Y: 1}, Up})
+		b.VisitPath(PositionWithDirection{Position{X: 1, Y: 1}, Right})
+		b.Visit(Position{X: 3, Y: 3})
+		b.VisitPath(PositionWithDirection{Position{X: 2, Y: 1}, Right})
+
+		return b
+	}
+
+	type test struct {
+		name string
+		o    RenderOptions[rune]
+		want []string
+	}
+
+	tests := []test{
+		{
+			name: "plain",
+			want: []string{
+				"....#",
+				".+@.*",
+				".^#..",
+				".^.X.",
+			},
+		},
+		{
+			name: "override",
+			o:    RenderOptions[rune]{Override: "O", Viewport: &Rect{Position{X: 3, Y: 0}, Position{X: 4, Y: 1}}},
+			want: []string{
+				".#",
+				".O",
+			},
+		},
+		{
+			name: "viewport",
+			o:    RenderOptions[rune]{Viewport: &Rect{Position{X: 1, Y: 1}, Position{X: 9, Y: 2}}},
+			want: []string{
+				"+@.*",
+				"^#..",
+			},
+		},
+		{
+			name: "format",
+			o:    RenderOptions[rune]{Format: func(r rune) string { return strings.ReplaceAll(string(r), ".", " ") }, Viewport: &Rect{Max: Position{X: 4, Y: 1}}},
+			want: []string{
+				"    #",
+				" +@ *",
+			},
+		},
+		{
+			name: "color",
+			o:    RenderOptions[rune]{Color: true, Viewport: &Rect{Position{X: 1, Y: 1}, Position{X: 4, Y: 1}}},
+			want: []string{
+				ansiYellow + "+" + ansiReset + ansiInverse + "@" + ansiReset + "." + ansiRed + "O" + ansiReset,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			b := board()
+
+			var sb strings.Builder
+
+			if err := b.Render(&sb, test.o); err != nil {
+				t.Fatal(err)
+			}
+
+			want := strings.Join(test.want, "\n") + "\n"
+
+			if got := sb.String(); got != want {
+				t.Errorf("got\n%q\nwant\n%q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package common
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// Connectivity selects which cells are neighbours on a grid
+type Connectivity int
+
+const (
+	Connect4 Connectivity = 4 // up, down, left and right
+	Connect8 Connectivity = 8 // including diagonals
+)
+
+// Edge is a move to a state at a cost
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// SearchResult holds the outcome of a shortest path search
+type SearchResult[S comparable] struct {
+	Dist  map[S]int // distance from the start to every reached state
+	Goals []S       // goal states reached at the shortest distance
+	prev  map[S][]S // predecessors of a state on its shortest paths
+}
+
+// Found reports whether any goal was reached
+func (r SearchResult[S]) Found() bool {
+	return len(r.Goals) > 0
+}
+
+// Distance returns the distance to the goal, or -1 if none was reached
+func (r SearchResult[S]) Distance() int {
+
+	if !r.Found() {
+		return -1
+	}
+
+	return r.Dist[r.Goals[0]]
+}
+
+// Path returns a shortest path from a start to the first goal,
+// both included, or nil if no goal was reached
+func (r SearchResult[S]) Path() []S {
+
+	if !r.Found() {
+		return nil
+	}
+
+	return r.PathTo(r.Goals[0])
+}
+
+// PathTo returns a shortest path from a start to s, which does not
+// need to be a goal, or nil if s was not reached
+func (r SearchResult[S]) PathTo(s S) []S {
+
+	if _, ok := r.Dist[s]; !ok {
+		return nil
+	}
+
+	path := []S{s}
+
+	for len(r.prev[s]) > 0 {
+		s = r.prev[s][0]
+		path = append(path, s)
+	}
+
+	reverse(path)
+
+	return path
+}
+
+// Paths returns every shortest path from a start to any of the
+// goals. Their number can grow exponentially with the grid size
+func (r SearchResult[S]) Paths() [][]S {
+
+	var paths [][]S
+
+	var walk func(s S, suffix []S)
+
+	walk = func(s S, suffix []S) {
+
+		path := append([]S{s}, suffix...)
+
+		if len(r.prev[s]) == 0 {
+			paths = append(paths, path)
+			return
+		}
+
+		for _, p := range r.prev[s] {
+			walk(p, path)
+		}
+	}
+
+	for _, g := range r.Goals {
+		walk(g, nil)
+	}
+
+	return paths
+}
+
+// OnPaths returns every state lying on any shortest path to a goal
+func (r SearchResult[S]) OnPaths() map[S]bool {
+
+	on := make(map[S]bool)
+
+	var walk func(s S)
+
+	walk = func(s S) {
+
+		if on[s] {
+			return
+		}
+
+		on[s] = true
+
+		for _, p := range r.prev[s] {
+			walk(p)
+		}
+	}
+
+	for _, g := range r.Goals {
+		walk(g)
+	}
+
+	return on
+}
+
+// BFS finds the shortest paths from any of the starts to a state
+// accepted by goal, where every move costs one. With a nil goal it
+// explores every reachable state
+func BFS[S comparable](starts []S, next func(S) []S, goal func(S) bool) SearchResult[S] {
+
+	r := newSearchResult[S]()
+
+	queue := make([]S, 0, len(starts))
+
+	for _, s := range starts {
+		if _, ok := r.Dist[s]; !ok {
+			r.Dist[s] = 0
+			queue = append(queue, s)
+		}
+	}
+
+	best := -1
+
+	for len(queue) > 0 {
+
+		s := queue[0]
+		queue = queue[1:]
+
+		d := r.Dist[s]
+
+		if best >= 0 && d > best {
+			break
+		}
+
+		if goal != nil && goal(s) {
+			best = d
+			r.Goals = append(r.Goals, s)
+			continue
+		}
+
+		for _, n := range next(s) {
+
+			old, seen := r.Dist[n]
+
+			switch {
+			case !seen:
+				r.Dist[n] = d + 1
+				r.prev[n] = []S{s}
+				queue = append(queue, n)
+			case old == d+1:
+				r.prev[n] = append(r.prev[n], s)
+			}
+		}
+	}
+
+	return r
+}
+
+// Dijkstra finds the cheapest paths from any of the starts to a
+// state accepted by goal. Costs must be positive. With a nil goal it
+// explores every reachable state
+func Dijkstra[S comparable](starts []S, next func(S) []Edge[S], goal func(S) bool) SearchResult[S] {
+	return AStar(starts, next, goal, nil)
+}
+
+// AStar is Dijkstra guided by the heuristic h, which must never
+// overestimate the remaining cost to a goal. A nil h is zero
+func AStar[S comparable](starts []S, next func(S) []Edge[S], goal func(S) bool, h func(S) int) SearchResult[S] {
+
+	if h == nil {
+		h = func(S) int { return 0 }
+	}
+
+	r := newSearchResult[S]()
+
+	q := &searchQueue[S]{}
+
+	for _, s := range starts {
+		if _, ok := r.Dist[s]; !ok {
+			r.Dist[s] = 0
+			heap.Push(q, searchItem[S]{s, h(s)})
+		}
+	}
+
+	best := -1
+
+	for q.Len() > 0 {
+
+		it := heap.Pop(q).(searchItem[S])
+
+		d := r.Dist[it.state]
+
+		// a cheaper path was found after it was queued
+		if it.priority > d+h(it.state) {
+			continue
+		}
+
+		if best >= 0 && it.priority > best {
+			break
+		}
+
+		if goal != nil && goal(it.state) {
+			best = d
+			r.Goals = append(r.Goals, it.state)
+			continue
+		}
+
+		for _, e := range next(it.state) {
+
+			nd := d + e.Cost
+			old, seen := r.Dist[e.To]
+
+			switch {
+			case !seen || nd < old:
+				r.Dist[e.To] = nd
+				r.prev[e.To] = []S{it.state}
+				heap.Push(q, searchItem[S]{e.To, nd + h(e.To)})
+			case nd == old:
+				r.prev[e.To] = append(r.prev[e.To], it.state)
+			}
+		}
+	}
+
+	return r
+}
+
+// Moves returns the neighbours of a position within b accepted by
+// passable, to use with BFS
+func (b *Board[T]) Moves(c Connectivity, passable func(from, to Position, v T) bool) func(Position) []Position {
+	return func(p Position) []Position {
+
+		var moves []Position
+
+		var buf [8]Neighbour
+
+		for _, n := range Neighbours[T](buf[:0], b, p, c) {
+
+			to := n.Position
+
+			v, _ := b.Get(to)
+
+			if passable(p, to, v) {
+				moves = append(moves, to)
+			}
+		}
+
+		return moves
+	}
+}
+
+// WeightedMoves returns the moves from a position within b, to use
+// with Dijkstra or AStar. cost returns the cost of a move and
+// whether it is possible at all
+func (b *Board[T]) WeightedMoves(c Connectivity, cost func(from, to Position, v T) (int, bool)) func(Position) []Edge[Position] {
+	return func(p Position) []Edge[Position] {
+
+		var edges []Edge[Position]
+
+		var buf [8]Neighbour
+
+		for _, n := range Neighbours[T](buf[:0], b, p, c) {
+
+			to := n.Position
+
+			v, _ := b.Get(to)
+
+			if w, ok := cost(p, to, v); ok {
+				edges = append(edges, Edge[Position]{to, w})
+			}
+		}
+
+		return edges
+	}
+}
+
+// TurningMoves returns the moves of a state facing a direction
+// within b, to use with Dijkstra or AStar: stepping forward into a
+// cell accepted by passable costs forward and turning left or right
+// in place costs turn
+func (b *Board[T]) TurningMoves(forward, turn int, passable func(p Position, v T) bool) func(PositionWithDirection) []Edge[PositionWithDirection] {
+	return func(s PositionWithDirection) []Edge[PositionWithDirection] {
+
+		edges := []Edge[PositionWithDirection]{
+			{PositionWithDirection{s.Position, s.Direction.TurnLeft()}, turn},
+			{PositionWithDirection{s.Position, s.Direction.TurnRight()}, turn},
+		}
+
+		to, ok := b.Move(s.Position, s.Direction)
+
+		if !ok {
+			return edges
+		}
+
+		if v, _ := b.Get(to); passable(to, v) {
+			edges = append(edges, Edge[PositionWithDirection]{PositionWithDirection{to, s.Direction}, forward})
+		}
+
+		return edges
+	}
+}
+
+// ManhattanTo returns a heuristic for AStar on a 4-connected grid
+// with unit costs towards goal
+func ManhattanTo(goal Position) func(Position) int {
+	return func(p Position) int {
+		return p.Manhattan(goal)
+	}
+}
+
+// directions returns the directions to the neighbours of a cell,
+// and panics for connectivities other than Connect4 and Connect8
+func (c Connectivity) directions() []Direction {
+
+	switch c {
+	case Connect4:
+		return directions4[:]
+	case Connect8:
+		return directions8[:]
+	}
+
+	panic(fmt.Sprintf("unsupported grid connectivity %d", c))
+}
+
+func newSearchResult[S comparable]() SearchResult[S] {
+	return SearchResult[S]{
+		Dist: make(map[S]int),
+		prev: make(map[S][]S),
+	}
+}
+
+type searchItem[S comparable] struct {
+	state    S
+	priority int
+}
+
+// searchQueue is a min heap of states by priority
+type searchQueue[S comparable] []searchItem[S]
+
+func (q searchQueue[S]) Len() int           { return len(q) }
+func (q searchQueue[S]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q searchQueue[S]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *searchQueue[S]) Push(x any)        { *q = append(*q, x.(searchItem[S])) }
+
+func (q *searchQueue[S]) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+func reverse[S any](s []S) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,187 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestBFS(t *testing.T) {
+
+	b := ParseRune([]string{
+		"...",
+		".#.",
+		"...",
+	})
+
+	open := func(from, to Position, v rune) bool { return v != '#' }
+
+	type test struct {
+		name  string
+		c     Connectivity
+		goal  Position
+		dist  int
+		paths int
+	}
+
+	tests := []test{
+		{name: "around the wall", c: Connect4, goal: Position{X: 2, Y: 2}, dist: 4, paths: 2},
+		{name: "next to start", c: Connect4, goal: Position{X: 1, Y: 0}, dist: 1, paths: 1},
+		{name: "diagonal", c: Connect8, goal: Position{X: 2, Y: 1}, dist: 2, paths: 1},
+		{name: "unreachable", c: Connect4, goal: Position{X: 1, Y: 1}, dist: -1, paths: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			r := BFS([]Position{{X: 0, Y: 0}}, b.Moves(test.c, open), func(p Position) bool { return p == test.goal })
+
+			if got := r.Distance(); got != test.dist {
+				t.Errorf("got %v, want %v", got, test.dist)
+			}
+
+			if got := len(r.Paths()); got != test.paths {
+				t.Errorf("got %v paths, want %v", got, test.paths)
+			}
+
+			if !r.Found() {
+				return
+			}
+
+			path := r.Path()
+
+			if len(path) != test.dist+1 || path[0] != (Position{X: 0, Y: 0}) || path[len(path)-1] != test.goal {
+				t.Errorf("got path %v, want %v steps to %v", path, test.dist, test.goal)
+			}
+		})
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+
+	b, err := ParseDigits([]string{
+		"191",
+		"111",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cost := func(from, to Position, v int) (int, bool) { return v, true }
+
+	start, goal := Position{X: 0, Y: 0}, Position{X: 2, Y: 0}
+
+	want := []Position{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 0}}
+
+	for name, h := range map[string]func(Position) int{
+		"dijkstra": nil,
+		"astar":    ManhattanTo(goal),
+	} {
+		t.Run(name, func(t *testing.T) {
+
+			r := AStar([]Position{start}, b.WeightedMoves(Connect4, cost), func(p Position) bool { return p == goal }, h)
+
+			if got := r.Distance(); got != 4 {
+				t.Errorf("got %v, want %v", got, 4)
+			}
+
+			got := r.Path()
+
+			if len(got) != len(want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+
+			for i := range got {
+				if got[i] != want[i] {
+					t.Fatalf("got %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTurningMoves(t *testing.T) {
+
+	b := ParseRune([]string{
+		"#####",
+		"#..E#",
+		"#.#.#",
+		"#S..#",
+		"#####",
+	})
+
+	start, end := Position{X: 1, Y: 3}, Position{X: 3, Y: 1}
+
+	type test struct {
+		name   string
+		starts []PositionWithDirection
+		dist   int
+		tiles  int
+	}
+
+	tests := []test{
+		{
+			name:   "facing right",
+			starts: []PositionWithDirection{{start, Right}},
+			dist:   1004,
+			tiles:  5,
+		},
+		{
+			name:   "facing up",
+			starts: []PositionWithDirection{{start, Up}},
+			dist:   1004,
+			tiles:  5,
+		},
+		{
+			name:   "either way",
+			starts: []PositionWithDirection{{start, Up}, {start, Right}},
+			dist:   1004,
+			tiles:  8,
+		},
+	}
+
+	moves := b.TurningMoves(1, 1000, func(p Position, v rune) bool { return v != '#' })
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			r := Dijkstra(test.starts, moves, func(s PositionWithDirection) bool { return s.Position == end })
+
+			if got := r.Distance(); got != test.dist {
+				t.Errorf("got %v, want %v", got, test.dist)
+			}
+
+			tiles := make(map[Position]bool)
+
+			for s := range r.OnPaths() {
+				tiles[s.Position] = true
+			}
+
+			if got := len(tiles); got != test.tiles {
+				t.Errorf("got %v tiles, want %v", got, test.tiles)
+			}
+		})
+	}
+}
+
+func TestBFSExhaustive(t *testing.T) {
+
+	b := ParseRune([]string{
+		"..#",
+		"..#",
+		"###",
+	})
+
+	r := BFS([]Position{{X: 0, Y: 0}}, b.Moves(Connect4, func(from, to Position, v rune) bool { return v == '.' }), nil)
+
+	if got := len(r.Dist); got != 4 {
+		t.Errorf("got %v, want %v", got, 4)
+	}
+
+	if got := r.Dist[Position{X: 1, Y: 1}]; got != 2 {
+		t.Errorf("got %v, want %v", got, 2)
+	}
+
+	if got := r.PathTo(Position{X: 1, Y: 1}); len(got) != 3 {
+		t.Errorf("got %v, want 3 steps", got)
+	}
+}
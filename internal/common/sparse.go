@@ -0,0 +1,207 @@
+package common
+
+// SparseBoard is a Grid backed by a map, for boards with huge or
+// growing coordinate ranges. Cells never set hold the zero value
+type SparseBoard[T any] struct {
+	pos    Position
+	cells  map[Position]T
+	bounds *Rect
+	wrap   bool
+	visits map[Position]int
+	paths  map[PositionWithDirection]int
+}
+
+// NewSparseBoard returns an empty sparse board. Positions outside
+// bounds are out of bounds, and a nil bounds leaves it unbounded
+func NewSparseBoard[T any](bounds *Rect) *SparseBoard[T] {
+
+	b := &SparseBoard[T]{
+		cells:  make(map[Position]T),
+		visits: make(map[Position]int),
+		paths:  make(map[PositionWithDirection]int),
+	}
+
+	if bounds != nil {
+		r := *bounds
+		b.bounds = &r
+	}
+
+	return b
+}
+
+// SparseFrom returns a sparse board holding the cells of b for
+// which keep returns true, bounded like b
+func SparseFrom[T any](b *Board[T], keep func(v T) bool) *SparseBoard[T] {
+
+	bounds := b.Bounds()
+
+	s := NewSparseBoard[T](&bounds)
+
+	d := b.GetDimension()
+
+	for y := 0; y < d.N; y++ {
+		for x := 0; x < d.M; x++ {
+
+			p := Position{X: x, Y: y}
+
+			if v, _ := b.Get(p); keep(v) {
+				s.cells[p] = v
+			}
+		}
+	}
+
+	return s
+}
+
+func (b *SparseBoard[T]) Get(p Position) (T, error) {
+
+	var zero T
+
+	if !b.inBounds(p) {
+		return zero, ErrOutOfBounds
+	}
+
+	return b.cells[p], nil
+}
+
+func (b *SparseBoard[T]) Set(p Position, v T) error {
+
+	if !b.inBounds(p) {
+		return ErrOutOfBounds
+	}
+
+	b.cells[p] = v
+
+	return nil
+}
+
+// Delete resets p to the zero value
+func (b *SparseBoard[T]) Delete(p Position) {
+	delete(b.cells, p)
+}
+
+// Cells returns the positions that have been set, in reading order
+func (b *SparseBoard[T]) Cells() []Position {
+
+	cells := make([]Position, 0, len(b.cells))
+
+	for p := range b.cells {
+		cells = append(cells, p)
+	}
+
+	return NewRegion(cells).Cells
+}
+
+// Len returns the number of cells that have been set
+func (b *SparseBoard[T]) Len() int {
+	return len(b.cells)
+}
+
+func (b *SparseBoard[T]) Visit(p Position) error {
+
+	if !b.inBounds(p) {
+		return ErrOutOfBounds
+	}
+
+	b.visits[p]++
+	b.pos = p
+
+	return nil
+}
+
+// VisitPath visits a position and increments the path count for
+// the given direction
+func (b *SparseBoard[T]) VisitPath(p PositionWithDirection) error {
+
+	if !b.inBounds(p.Position) {
+		return ErrOutOfBounds
+	}
+
+	b.paths[p]++
+
+	return b.Visit(p.Position)
+}
+
+func (b *SparseBoard[T]) GetVisits(p Position) int {
+	return b.visits[p]
+}
+
+func (b *SparseBoard[T]) ResetVisits() {
+	b.visits = make(map[Position]int)
+}
+
+func (b *SparseBoard[T]) GetPaths(p PositionWithDirection) int {
+	return b.paths[p]
+}
+
+func (b *SparseBoard[T]) ResetPaths() {
+	b.paths = make(map[PositionWithDirection]int)
+}
+
+func (b *SparseBoard[T]) GetVisited() []Position {
+
+	visited := make([]Position, 0, len(b.visits))
+
+	for p := range b.visits {
+		visited = append(visited, p)
+	}
+
+	return visited
+}
+
+func (b *SparseBoard[T]) GetPosition() Position {
+	return b.pos
+}
+
+// Bounds returns the bounds of the board or, when unbounded, the
+// smallest rectangle holding every set or visited cell
+func (b *SparseBoard[T]) Bounds() Rect {
+
+	if b.bounds != nil {
+		return *b.bounds
+	}
+
+	cells := make([]Position, 0, len(b.cells)+len(b.visits))
+
+	for p := range b.cells {
+		cells = append(cells, p)
+	}
+
+	for p := range b.visits {
+		cells = append(cells, p)
+	}
+
+	if len(cells) == 0 {
+		return Rect{Max: Position{X: -1, Y: -1}}
+	}
+
+	return NewRegion(cells).Bounds()
+}
+
+// SetWrap makes moves past an edge come back from the opposite one.
+// As on Board it only applies to Move and Neighbours, Get and Set
+// still reject positions out of bounds. It has no effect on
+// unbounded boards
+func (b *SparseBoard[T]) SetWrap(wrap bool) {
+	b.wrap = wrap
+}
+
+// Move returns the position next to p in direction d
+func (b *SparseBoard[T]) Move(p Position, d Direction) (Position, bool) {
+
+	o := d.Delta()
+
+	if o == (Position{}) {
+		return p, false
+	}
+
+	if b.bounds == nil {
+		return p.Add(o), true
+	}
+
+	return step(*b.bounds, b.wrap, p, o)
+}
+
+func (b *SparseBoard[T]) inBounds(p Position) bool {
+	return b.bounds == nil || b.bounds.Contains(p)
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Outcome int
+
+const (
+	Unknown Outcome = iota
+	Correct
+	TooHigh
+	TooLow
+	Wrong
+	Wait
+	AlreadySolved
+)
+
+var outcomes = [...]string{"Unknown", "Correct", "TooHigh", "TooLow", "Wrong", "Wait", "AlreadySolved"}
+
+var (
+	reArticle = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	reTag     = regexp.MustCompile(`<[^>]*>`)
+	reSpace   = regexp.MustCompile(`\s+`)
+	reLeft    = regexp.MustCompile(`You have (?:(\d+)m ?)?(?:(\d+)s )?left to wait`)
+)
+
+// Result is the outcome of submitting an answer
+type Result struct {
+	Outcome Outcome
+	Wait    time.Duration // time left before the next attempt, if known
+	Message string        // text of the response page
+}
+
+func (o Outcome) String() string {
+
+	if o < 0 || int(o) >= len(outcomes) {
+		return outcomes[Unknown]
+	}
+
+	return outcomes[o]
+}
+
+func (o Outcome) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+func (o *Outcome) UnmarshalText(b []byte) error {
+
+	for i, s := range outcomes {
+		if s == string(b) {
+			*o = Outcome(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown outcome %q", b)
+}
+
+// Submit posts answer for the given part of a puzzle and returns
+// the outcome parsed from the response page
+func (c *Client) Submit(ctx context.Context, year, day int, p Part, answer string) (Result, error) {
+
+	// a retried POST could count as a second attempt
+	once := *c
+	once.Retries = 0
+
+	form := url.Values{
+		"level":  {p.String()},
+		"answer": {answer},
+	}
+
+	b, err := once.do(ctx, http.MethodPost, fmt.Sprintf("/%v/day/%v/answer", year, day), form)
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	return ParseResult(string(b)), nil
+}
+
+// ParseResult extracts the outcome from an answer response page
+func ParseResult(page string) Result {
+
+	msg := page
+
+	if m := reArticle.FindStringSubmatch(page); m != nil {
+		msg = m[1]
+	}
+
+	msg = html.UnescapeString(reTag.ReplaceAllString(msg, ""))
+	msg = strings.TrimSpace(reSpace.ReplaceAllString(msg, " "))
+
+	r := Result{
+		Message: msg,
+	}
+
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		r.Outcome = Correct
+	case strings.Contains(msg, "your answer is too high"):
+		r.Outcome = TooHigh
+	case strings.Contains(msg, "your answer is too low"):
+		r.Outcome = TooLow
+	case strings.Contains(msg, "That's not the right answer"):
+		r.Outcome = Wrong
+	case strings.Contains(msg, "You gave an answer too recently"):
+		r.Outcome = Wait
+	case strings.Contains(msg, "Did you already complete it"):
+		r.Outcome = AlreadySolved
+	}
+
+	if m := reLeft.FindStringSubmatch(msg); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+
+	return r
+}
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseResult(t *testing.T) {
+
+	type test struct {
+		name    string
+		page    string
+		outcome Outcome
+		wait    time.Duration
+	}
+
+	tests := []test{
+		{
+			name:    "correct",
+			page:    `<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/6#part2">[Continue to Part Two]</a></p></article></main>`,
+			outcome: Correct,
+		},
+		{
+			name:    "too high",
+			page:    `<main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data; there are also some general tips on the <a href="/2024/about">about page</a>, or you can ask for hints on the <a href="https://www.reddit.com/r/adventofcode/" target="_blank">subreddit</a>.  Please wait one minute before trying again. <a href="/2024/day/6">[Return to Day 6]</a></p></article></main>`,
+			outcome: TooHigh,
+		},
+		{
+			name:    "too low",
+			page:    `<article><p>That's not the right answer; your answer is too low.  Please wait one minute before trying again.</p></article>`,
+			outcome: TooLow,
+		},
+		{
+			name:    "wrong",
+			page:    `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`,
+			outcome: Wrong,
+		},
+		{
+			name:    "wait seconds",
+			page:    `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait. <a href="/2024/day/6">[Return to Day 6]</a></p></article>`,
+			outcome: Wait,
+			wait:    34 * time.Second,
+		},
+		{
+			name:    "wait minutes",
+			page:    `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 2s left to wait.</p></article>`,
+			outcome: Wait,
+			wait:    4*time.Minute + 2*time.Second,
+		},
+		{
+			name:    "already solved",
+			page:    `<article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/6">[Return to Day 6]</a></p></article>`,
+			outcome: AlreadySolved,
+		},
+		{
+			name:    "unknown",
+			page:    `<html><body>something else</body></html>`,
+			outcome: Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			r := ParseResult(test.page)
+
+			if r.Outcome != test.outcome {
+				t.Errorf("got %v, want %v (%q)", r.Outcome, test.outcome, r.Message)
+			}
+
+			if r.Wait != test.wait {
+				t.Errorf("got %v, want %v", r.Wait, test.wait)
+			}
+		})
+	}
+}
+
+func TestClientSubmit(t *testing.T) {
+
+	var calls int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		calls++
+
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/6/answer" {
+			t.Errorf("got %v %v, want POST /2024/day/6/answer", r.Method, r.URL.Path)
+		}
+
+		if r.FormValue("level") != "2" {
+			t.Errorf("got level %q, want 2", r.FormValue("level"))
+		}
+
+		if r.FormValue("answer") == "1719" {
+			w.Write([]byte(`<article><p>That's the right answer!</p></article>`))
+			return
+		}
+
+		w.Write([]byte(`<article><p>That's not the right answer; your answer is too low.</p></article>`))
+	}))
+
+	defer srv.Close()
+
+	c := NewClient("token")
+	c.BaseURL = srv.URL
+
+	for answer, want := range map[string]Outcome{"1719": Correct, "12": TooLow} {
+
+		r, err := c.Submit(context.Background(), 2024, 6, Part2, answer)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Outcome != want {
+			t.Errorf("got %v, want %v for %v", r.Outcome, want, answer)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("got %v calls, want 2", calls)
+	}
+}
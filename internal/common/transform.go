@@ -0,0 +1,81 @@
+package common
+
+// Transformations return new boards with the cells as returned by
+// Get, so overrides are applied, and with no visits, paths or
+// overrides of their own
+
+// Rotate returns a copy of b rotated clockwise by the given number
+// of quarter turns. Negative turns rotate counterclockwise
+func (b *Board[T]) Rotate(turns int) Board[T] {
+
+	d := b.GetDimension()
+
+	switch (turns%4 + 4) % 4 {
+	case 1:
+		return b.transform(d.M, d.N, func(x, y int) Position { return Position{X: y, Y: d.N - 1 - x} })
+	case 2:
+		return b.transform(d.N, d.M, func(x, y int) Position { return Position{X: d.M - 1 - x, Y: d.N - 1 - y} })
+	case 3:
+		return b.transform(d.M, d.N, func(x, y int) Position { return Position{X: d.M - 1 - y, Y: x} })
+	}
+
+	return b.transform(d.N, d.M, func(x, y int) Position { return Position{X: x, Y: y} })
+}
+
+// FlipHorizontal returns a copy of b mirrored left to right
+func (b *Board[T]) FlipHorizontal() Board[T] {
+	d := b.GetDimension()
+	return b.transform(d.N, d.M, func(x, y int) Position { return Position{X: d.M - 1 - x, Y: y} })
+}
+
+// FlipVertical returns a copy of b mirrored top to bottom
+func (b *Board[T]) FlipVertical() Board[T] {
+	d := b.GetDimension()
+	return b.transform(d.N, d.M, func(x, y int) Position { return Position{X: x, Y: d.N - 1 - y} })
+}
+
+// Transpose returns a copy of b mirrored along its main diagonal, so
+// rows become columns
+func (b *Board[T]) Transpose() Board[T] {
+	d := b.GetDimension()
+	return b.transform(d.M, d.N, func(x, y int) Position { return Position{X: y, Y: x} })
+}
+
+// Sub returns a copy of the cells of b within r, which must lie
+// inside the board
+func (b *Board[T]) Sub(r Rect) (Board[T], error) {
+
+	bounds := b.Bounds()
+
+	if r.Min.X > r.Max.X || r.Min.Y > r.Max.Y || !bounds.Contains(r.Min) || !bounds.Contains(r.Max) {
+		return Board[T]{}, ErrOutOfBounds
+	}
+
+	d := r.Dimension()
+
+	return b.transform(d.N, d.M, func(x, y int) Position { return Position{X: r.Min.X + x, Y: r.Min.Y + y} }), nil
+}
+
+// Tile returns a board made of n rows of m copies of b
+func (b *Board[T]) Tile(n, m int) Board[T] {
+	d := b.GetDimension()
+	return b.transform(d.N*max(n, 0), d.M*max(m, 0), func(x, y int) Position { return Position{X: x % d.M, Y: y % d.N} })
+}
+
+// transform returns a board of n rows and m columns where every
+// cell holds the one of b at src
+func (b *Board[T]) transform(n, m int, src func(x, y int) Position) Board[T] {
+
+	grid := make([][]T, n)
+
+	for y := range grid {
+
+		grid[y] = make([]T, m)
+
+		for x := range grid[y] {
+			grid[y][x], _ = b.Get(src(x, y))
+		}
+	}
+
+	return newBoard(grid)
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTransform(t *testing.T) {
+
+	b := ParseRune([]string{
+		"abc",
+		"def",
+	})
+
+	b = b.WithOverrides(map[Position]rune{{X: 2, Y: 1}: 'F'})
+
+	type test struct {
+		name string
+		f    func() Board[rune]
+		want []string
+	}
+
+	tests := []test{
+		{name: "rotate 0", f: func() Board[rune] { return b.Rotate(0) }, want: []string{"abc", "deF"}},
+		{name: "rotate 90", f: func() Board[rune] { return b.Rotate(1) }, want: []string{"da", "eb", "Fc"}},
+		{name: "rotate 180", f: func() Board[rune] { return b.Rotate(2) }, want: []string{"Fed", "cba"}},
+		{name: "rotate 270", f: func() Board[rune] { return b.Rotate(3) }, want: []string{"cF", "be", "ad"}},
+		{name: "rotate -90", f: func() Board[rune] { return b.Rotate(-1) }, want: []string{"cF", "be", "ad"}},
+		{name: "flip horizontal", f: b.FlipHorizontal, want: []string{"cba", "Fed"}},
+		{name: "flip vertical", f: b.FlipVertical, want: []string{"deF", "abc"}},
+		{name: "transpose", f: b.Transpose, want: []string{"ad", "be", "cF"}},
+		{name: "tile", f: func() Board[rune] { return b.Tile(2, 2) }, want: []string{"abcabc", "deFdeF", "abcabc", "deFdeF"}},
+		{
+			name: "sub",
+			f: func() Board[rune] {
+				s, err := b.Sub(Rect{Min: Position{X: 1, Y: 0}, Max: Position{X: 2, Y: 1}})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return s
+			},
+			want: []string{"bc", "eF"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			got := test.f()
+
+			if want := ParseRune(test.want); !reflect.DeepEqual(got.grid, want.grid) {
+				t.Errorf("got %v, want %v", lines(got), test.want)
+			}
+		})
+	}
+}
+
+func TestSubOutOfBounds(t *testing.T) {
+
+	b := ParseRune([]string{
+		"abc",
+		"def",
+	})
+
+	if _, err := b.Sub(Rect{Min: Position{X: 1, Y: 1}, Max: Position{X: 3, Y: 1}}); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("got %v, want %v", err, ErrOutOfBounds)
+	}
+}
+
+func lines(b Board[rune]) []string {
+
+	var s []string
+
+	for _, row := range b.grid {
+		s = append(s, string(row))
+	}
+
+	return s
+}
@@ -2,6 +2,9 @@ package common
 
 import "strconv"
 
+// DefaultYear is the event year used when none is given
+const DefaultYear = 2024
+
 type Part int
 
 const (
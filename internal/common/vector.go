@@ -0,0 +1,120 @@
+package common
+
+// directions4 holds the orthogonal directions clockwise from Up
+var directions4 = [...]Direction{Up, Right, Down, Left}
+
+// directions8 holds every direction clockwise from Up
+var directions8 = [...]Direction{Up, Upright, Right, Downright, Down, Downleft, Left, Upleft}
+
+// Directions4 returns a copy of the orthogonal directions clockwise
+// from Up
+func Directions4() [4]Direction {
+	return directions4
+}
+
+// Directions8 returns a copy of every direction clockwise from Up
+func Directions8() [8]Direction {
+	return directions8
+}
+
+// deltas holds the change of position of a step in each direction
+var deltas = [...]Position{
+	Up:        {X: 0, Y: -1},
+	Down:      {X: 0, Y: 1},
+	Left:      {X: -1, Y: 0},
+	Right:     {X: 1, Y: 0},
+	Upleft:    {X: -1, Y: -1},
+	Upright:   {X: 1, Y: -1},
+	Downleft:  {X: -1, Y: 1},
+	Downright: {X: 1, Y: 1},
+}
+
+// clockwise holds the index of each direction in directions8
+var clockwise = [...]int{
+	Up:        0,
+	Upright:   1,
+	Right:     2,
+	Downright: 3,
+	Down:      4,
+	Downleft:  5,
+	Left:      6,
+	Upleft:    7,
+}
+
+// Add returns p moved by q
+func (p Position) Add(q Position) Position {
+	return Position{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub returns p moved back by q, the offset from q to p
+func (p Position) Sub(q Position) Position {
+	return Position{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Scale returns p with both coordinates multiplied by k
+func (p Position) Scale(k int) Position {
+	return Position{X: p.X * k, Y: p.Y * k}
+}
+
+// Step returns the position n cells away from p in direction d
+func (p Position) Step(d Direction, n int) Position {
+	return p.Add(d.Delta().Scale(n))
+}
+
+// Manhattan returns the number of orthogonal steps between p and q
+func (p Position) Manhattan(q Position) int {
+	d := p.Sub(q)
+	return abs(d.X) + abs(d.Y)
+}
+
+// Chebyshev returns the number of steps between p and q when moving
+// diagonally is allowed
+func (p Position) Chebyshev(q Position) int {
+	d := p.Sub(q)
+	return max(abs(d.X), abs(d.Y))
+}
+
+// Delta returns the change of position of one step in direction d,
+// zero if it is Unspecified
+func (d Direction) Delta() Position {
+
+	if d < 0 || int(d) >= len(deltas) {
+		return Position{}
+	}
+
+	return deltas[d]
+}
+
+// Opposite returns the direction pointing the other way
+func (d Direction) Opposite() Direction {
+	return d.rotate(4)
+}
+
+// TurnRight45 returns the direction 45 degrees clockwise from d
+func (d Direction) TurnRight45() Direction {
+	return d.rotate(1)
+}
+
+// TurnLeft45 returns the direction 45 degrees counterclockwise from d
+func (d Direction) TurnLeft45() Direction {
+	return d.rotate(7)
+}
+
+// rotate returns the direction n eighths of a turn clockwise from d
+func (d Direction) rotate(n int) Direction {
+
+	if d <= Unspecified || int(d) >= len(clockwise) {
+		return Unspecified
+	}
+
+	return directions8[(clockwise[d]+n)%len(directions8)]
+}
+
+func abs(n int) int {
+
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,92 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestPositionArithmetic(t *testing.T) {
+
+	p, q := Position{X: 2, Y: -3}, Position{X: -1, Y: 5}
+
+	type test struct {
+		name string
+		got  any
+		want any
+	}
+
+	tests := []test{
+		{name: "add", got: p.Add(q), want: Position{X: 1, Y: 2}},
+		{name: "sub", got: p.Sub(q), want: Position{X: 3, Y: -8}},
+		{name: "scale", got: p.Scale(-2), want: Position{X: -4, Y: 6}},
+		{name: "step", got: p.Step(Downleft, 3), want: Position{X: -1, Y: 0}},
+		{name: "manhattan", got: p.Manhattan(q), want: 11},
+		{name: "chebyshev", got: p.Chebyshev(q), want: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got %v, want %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestDirections(t *testing.T) {
+
+	type test struct {
+		d        Direction
+		delta    Position
+		opposite Direction
+		right45  Direction
+		left45   Direction
+	}
+
+	tests := []test{
+		{d: Up, delta: Position{X: 0, Y: -1}, opposite: Down, right45: Upright, left45: Upleft},
+		{d: Right, delta: Position{X: 1, Y: 0}, opposite: Left, right45: Downright, left45: Upright},
+		{d: Downleft, delta: Position{X: -1, Y: 1}, opposite: Upright, right45: Left, left45: Down},
+		{d: Upleft, delta: Position{X: -1, Y: -1}, opposite: Downright, right45: Up, left45: Left},
+		{d: Unspecified, delta: Position{}, opposite: Unspecified, right45: Unspecified, left45: Unspecified},
+	}
+
+	for _, test := range tests {
+		t.Run(test.d.String(), func(t *testing.T) {
+
+			if got := test.d.Delta(); got != test.delta {
+				t.Errorf("got delta %v, want %v", got, test.delta)
+			}
+
+			if got := test.d.Opposite(); got != test.opposite {
+				t.Errorf("got opposite %v, want %v", got, test.opposite)
+			}
+
+			if got := test.d.TurnRight45(); got != test.right45 {
+				t.Errorf("got %v turning right, want %v", got, test.right45)
+			}
+
+			if got := test.d.TurnLeft45(); got != test.left45 {
+				t.Errorf("got %v turning left, want %v", got, test.left45)
+			}
+		})
+	}
+
+	// every direction is listed once, and two 45 degree turns make
+	// a 90 degree one
+	seen := make(map[Direction]bool)
+
+	for _, d := range Directions8() {
+
+		if seen[d] {
+			t.Errorf("got %v twice", d)
+		}
+
+		seen[d] = true
+	}
+
+	for _, d := range Directions4() {
+		if got := d.TurnRight45().TurnRight45(); got != d.TurnRight() {
+			t.Errorf("got %v, want %v", got, d.TurnRight())
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package common
+
+import "fmt"
+
+const (
+	Connect6  Connectivity = 6  // voxels sharing a face
+	Connect26 Connectivity = 26 // voxels sharing a face, edge or corner
+)
+
+// Voxel is a cell of a 3D grid
+type Voxel struct {
+	X, Y, Z int
+}
+
+// VoxelWithDirection is a voxel and the unit step it was entered by
+type VoxelWithDirection struct {
+	Voxel
+	Direction Voxel
+}
+
+// Box is the cuboid between two corners, both included
+type Box struct {
+	Min, Max Voxel
+}
+
+// faces lists the unit steps to the six voxels sharing a face
+var faces = [...]Voxel{
+	{X: 1}, {X: -1},
+	{Y: 1}, {Y: -1},
+	{Z: 1}, {Z: -1},
+}
+
+// Add returns v moved by o
+func (v Voxel) Add(o Voxel) Voxel {
+	return Voxel{X: v.X + o.X, Y: v.Y + o.Y, Z: v.Z + o.Z}
+}
+
+// Manhattan returns the number of face steps between v and o
+func (v Voxel) Manhattan(o Voxel) int {
+	return abs(v.X-o.X) + abs(v.Y-o.Y) + abs(v.Z-o.Z)
+}
+
+// Neighbours returns the voxels next to v, the 6 sharing a face
+// with Connect6 and all 26 around it with Connect26. They are
+// always listed in the same order. It panics with any other
+// connectivity
+func (v Voxel) Neighbours(c Connectivity) []Voxel {
+
+	switch c {
+	case Connect6:
+
+		n := make([]Voxel, 0, len(faces))
+
+		for _, o := range faces {
+			n = append(n, v.Add(o))
+		}
+
+		return n
+
+	case Connect26:
+
+		n := make([]Voxel, 0, 26)
+
+		for dz := -1; dz <= 1; dz++ {
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					if dx != 0 || dy != 0 || dz != 0 {
+						n = append(n, v.Add(Voxel{X: dx, Y: dy, Z: dz}))
+					}
+				}
+			}
+		}
+
+		return n
+	}
+
+	panic(fmt.Sprintf("unsupported voxel connectivity %d", c))
+}
+
+// Contains reports whether v lies within b
+func (b Box) Contains(v Voxel) bool {
+	return v.X >= b.Min.X && v.X <= b.Max.X &&
+		v.Y >= b.Min.Y && v.Y <= b.Max.Y &&
+		v.Z >= b.Min.Z && v.Z <= b.Max.Z
+}
+
+// Grow returns b extended by n voxels on every side
+func (b Box) Grow(n int) Box {
+	return Box{
+		Min: b.Min.Add(Voxel{X: -n, Y: -n, Z: -n}),
+		Max: b.Max.Add(Voxel{X: n, Y: n, Z: n}),
+	}
+}
+
+// VoxelGrid is an unbounded 3D grid backed by a map, tracking visits
+// and paths like Board. Cells never set hold the zero value
+type VoxelGrid[T any] struct {
+	pos    Voxel
+	cells  map[Voxel]T
+	visits map[Voxel]int
+	paths  map[VoxelWithDirection]int
+}
+
+// NewVoxelGrid returns an empty 3D grid
+func NewVoxelGrid[T any]() *VoxelGrid[T] {
+	return &VoxelGrid[T]{
+		cells:  make(map[Voxel]T),
+		visits: make(map[Voxel]int),
+		paths:  make(map[VoxelWithDirection]int),
+	}
+}
+
+func (g *VoxelGrid[T]) Get(v Voxel) T {
+	return g.cells[v]
+}
+
+func (g *VoxelGrid[T]) Set(v Voxel, value T) {
+	g.cells[v] = value
+}
+
+// Cells returns the voxels that have been set
+func (g *VoxelGrid[T]) Cells() []Voxel {
+
+	cells := make([]Voxel, 0, len(g.cells))
+
+	for v := range g.cells {
+		cells = append(cells, v)
+	}
+
+	return cells
+}
+
+// Bounds returns the smallest box holding every voxel set
+func (g *VoxelGrid[T]) Bounds() Box {
+
+	var b Box
+
+	first := true
+
+	for v := range g.cells {
+
+		if first {
+			b, first = Box{Min: v, Max: v}, false
+			continue
+		}
+
+		b.Min = Voxel{X: min(b.Min.X, v.X), Y: min(b.Min.Y, v.Y), Z: min(b.Min.Z, v.Z)}
+		b.Max = Voxel{X: max(b.Max.X, v.X), Y: max(b.Max.Y, v.Y), Z: max(b.Max.Z, v.Z)}
+	}
+
+	return b
+}
+
+func (g *VoxelGrid[T]) Visit(v Voxel) {
+	g.visits[v]++
+	g.pos = v
+}
+
+// VisitPath visits a voxel and increments the path count for the
+// given direction
+func (g *VoxelGrid[T]) VisitPath(v VoxelWithDirection) {
+	g.paths[v]++
+	g.Visit(v.Voxel)
+}
+
+func (g *VoxelGrid[T]) GetVisits(v Voxel) int {
+	return g.visits[v]
+}
+
+func (g *VoxelGrid[T]) ResetVisits() {
+	g.visits = make(map[Voxel]int)
+}
+
+func (g *VoxelGrid[T]) GetPaths(v VoxelWithDirection) int {
+	return g.paths[v]
+}
+
+func (g *VoxelGrid[T]) ResetPaths() {
+	g.paths = make(map[VoxelWithDirection]int)
+}
+
+func (g *VoxelGrid[T]) GetVisited() []Voxel {
+
+	visited := make([]Voxel, 0, len(g.visits))
+
+	for v := range g.visits {
+		visited = append(visited, v)
+	}
+
+	return visited
+}
+
+func (g *VoxelGrid[T]) GetPosition() Voxel {
+	return g.pos
+}
+
+// FloodFill returns the voxels within box accepted by in that are
+// connected to start. It is empty if start itself is not accepted
+func (g *VoxelGrid[T]) FloodFill(start Voxel, c Connectivity, box Box, in func(v Voxel, value T) bool) map[Voxel]bool {
+
+	filled := make(map[Voxel]bool)
+
+	if !box.Contains(start) || !in(start, g.cells[start]) {
+		return filled
+	}
+
+	next := func(v Voxel) []Voxel {
+
+		var n []Voxel
+
+		for _, o := range v.Neighbours(c) {
+			if box.Contains(o) && in(o, g.cells[o]) {
+				n = append(n, o)
+			}
+		}
+
+		return n
+	}
+
+	for v := range BFS([]Voxel{start}, next, nil).Dist {
+		filled[v] = true
+	}
+
+	return filled
+}
+
+// SurfaceArea returns the number of faces between solid voxels and
+// the rest, including those facing air trapped inside
+func (g *VoxelGrid[T]) SurfaceArea(solid func(value T) bool) int {
+
+	var n int
+
+	for v, value := range g.cells {
+
+		if !solid(value) {
+			continue
+		}
+
+		for _, o := range v.Neighbours(Connect6) {
+			if !solid(g.cells[o]) {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// ExteriorSurfaceArea returns the number of faces between solid
+// voxels and the air reachable from outside of them
+func (g *VoxelGrid[T]) ExteriorSurfaceArea(solid func(value T) bool) int {
+
+	box := g.Bounds().Grow(1)
+
+	outside := g.FloodFill(box.Min, Connect6, box, func(_ Voxel, value T) bool { return !solid(value) })
+
+	var n int
+
+	for v, value := range g.cells {
+
+		if !solid(value) {
+			continue
+		}
+
+		for _, o := range v.Neighbours(Connect6) {
+			if outside[o] {
+				n++
+			}
+		}
+	}
+
+	return n
+}
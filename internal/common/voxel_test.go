@@ -0,0 +1,126 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSurfaceArea(t *testing.T) {
+
+	input := []string{
+		"2,2,2",
+		"1,2,2",
+		"3,2,2",
+		"2,1,2",
+		"2,3,2",
+		"2,2,1",
+		"2,2,3",
+		"2,2,4",
+		"2,2,6",
+		"1,2,5",
+		"3,2,5",
+		"2,1,5",
+		"2,3,5",
+	}
+
+	g := NewVoxelGrid[bool]()
+
+	for _, line := range input {
+
+		var v Voxel
+
+		if _, err := fmt.Sscanf(line, "%d,%d,%d", &v.X, &v.Y, &v.Z); err != nil {
+			t.Fatal(err)
+		}
+
+		g.Set(v, true)
+	}
+
+	solid := func(value bool) bool { return value }
+
+	if got := g.SurfaceArea(solid); got != 64 {
+		t.Errorf("got %v, want %v", got, 64)
+	}
+
+	if got := g.ExteriorSurfaceArea(solid); got != 58 {
+		t.Errorf("got %v exterior, want %v", got, 58)
+	}
+}
+
+func TestVoxelNeighbours(t *testing.T) {
+
+	v := Voxel{X: 1, Y: -2, Z: 3}
+
+	type test struct {
+		c        Connectivity
+		want     int
+		farthest int
+	}
+
+	tests := []test{
+		{c: Connect6, want: 6, farthest: 1},
+		{c: Connect26, want: 26, farthest: 3},
+	}
+
+	for _, test := range tests {
+
+		seen := make(map[Voxel]bool)
+
+		var farthest int
+
+		for _, n := range v.Neighbours(test.c) {
+			seen[n] = true
+			farthest = max(farthest, v.Manhattan(n))
+		}
+
+		if len(seen) != test.want || seen[v] {
+			t.Errorf("got %v distinct neighbours, want %v", len(seen), test.want)
+		}
+
+		if farthest != test.farthest {
+			t.Errorf("got %v, want %v", farthest, test.farthest)
+		}
+	}
+
+	for _, c := range []Connectivity{0, Connect4, Connect8} {
+		func() {
+
+			defer func() {
+				if recover() == nil {
+					t.Errorf("got no panic, want one for connectivity %v", c)
+				}
+			}()
+
+			v.Neighbours(c)
+		}()
+	}
+}
+
+func TestVoxelFloodFill(t *testing.T) {
+
+	g := NewVoxelGrid[bool]()
+
+	// a wall at x = 1 splits the box in two
+	for y := 0; y <= 2; y++ {
+		for z := 0; z <= 2; z++ {
+			g.Set(Voxel{X: 1, Y: y, Z: z}, true)
+		}
+	}
+
+	box := Box{Max: Voxel{X: 2, Y: 2, Z: 2}}
+	air := func(_ Voxel, value bool) bool { return !value }
+
+	if got := len(g.FloodFill(Voxel{}, Connect6, box, air)); got != 9 {
+		t.Errorf("got %v, want %v", got, 9)
+	}
+
+	if got := len(g.FloodFill(Voxel{}, Connect26, box.Grow(1), air)); got != 5*5*5-9 {
+		t.Errorf("got %v, want %v", got, 5*5*5-9)
+	}
+
+	g.VisitPath(VoxelWithDirection{Voxel{X: 0, Y: 0, Z: 1}, Voxel{Z: 1}})
+
+	if got := g.GetVisits(Voxel{X: 0, Y: 0, Z: 1}); got != 1 {
+		t.Errorf("got %v visits, want %v", got, 1)
+	}
+}
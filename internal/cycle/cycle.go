@@ -0,0 +1,161 @@
+// Package cycle finds repeating states in simulations, so puzzles
+// asking for the state after a huge number of steps can skip ahead
+package cycle
+
+import (
+	"errors"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+var (
+	// Errors
+	ErrNegativeSteps = errors.New("negative number of steps")
+)
+
+// Cycle describes the states reached by stepping from a start state
+// over and over: after Start steps they repeat every Length steps
+type Cycle struct {
+	Start  int
+	Length int
+}
+
+// Index returns the number of steps, below Start+Length, that reach
+// the same state as n steps
+func (c Cycle) Index(n int) int {
+
+	if n < c.Start || c.Length == 0 {
+		return n
+	}
+
+	return c.Start + (n-c.Start)%c.Length
+}
+
+// Floyd finds the cycle with Floyd's tortoise and hare in constant
+// memory. step must eventually repeat a state or it never returns
+func Floyd[S comparable](start S, step func(S) S) Cycle {
+	return FloydFunc(start, step, func(a, b S) bool { return a == b })
+}
+
+// FloydFunc is Floyd for states compared with equal
+func FloydFunc[S any](start S, step func(S) S, equal func(a, b S) bool) Cycle {
+
+	tortoise, hare := step(start), step(step(start))
+
+	for !equal(tortoise, hare) {
+		tortoise, hare = step(tortoise), step(step(hare))
+	}
+
+	var c Cycle
+
+	tortoise = start
+
+	for !equal(tortoise, hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		c.Start++
+	}
+
+	c.Length = 1
+
+	for hare = step(tortoise); !equal(tortoise, hare); hare = step(hare) {
+		c.Length++
+	}
+
+	return c
+}
+
+// Brent finds the cycle with Brent's algorithm in constant memory,
+// usually calling step fewer times than Floyd. step must eventually
+// repeat a state or it never returns
+func Brent[S comparable](start S, step func(S) S) Cycle {
+	return BrentFunc(start, step, func(a, b S) bool { return a == b })
+}
+
+// BrentFunc is Brent for states compared with equal
+func BrentFunc[S any](start S, step func(S) S, equal func(a, b S) bool) Cycle {
+
+	c := Cycle{Length: 1}
+
+	power := 1
+	tortoise, hare := start, step(start)
+
+	for !equal(tortoise, hare) {
+
+		if power == c.Length {
+			tortoise = hare
+			power *= 2
+			c.Length = 0
+		}
+
+		hare = step(hare)
+		c.Length++
+	}
+
+	tortoise, hare = start, start
+
+	for i := 0; i < c.Length; i++ {
+		hare = step(hare)
+	}
+
+	for !equal(tortoise, hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		c.Start++
+	}
+
+	return c
+}
+
+// Detect steps from start until a state repeats, remembering each
+// one by key. It returns the cycle and the states before the repeat,
+// so states[c.Index(n)] is the state after n steps
+func Detect[S any, K comparable](start S, step func(S) S, key func(S) K) (Cycle, []S) {
+	return detect(start, step, key, -1)
+}
+
+// Extrapolate returns the state after n steps from start, stepping
+// only until a state repeats. n must not be negative
+func Extrapolate[S any, K comparable](start S, step func(S) S, key func(S) K, n int) (S, error) {
+
+	if n < 0 {
+		var zero S
+		return zero, ErrNegativeSteps
+	}
+
+	c, states := detect(start, step, key, n)
+
+	return states[c.Index(n)], nil
+}
+
+// BoardHash is a key for boards, to use with Detect or Extrapolate.
+// Boards are told apart by hash alone, so the step function must
+// return a new board, not update the one given
+func BoardHash[T any](b common.Board[T]) uint64 {
+	return b.Hash()
+}
+
+// detect is Detect stopping early once limit steps have been taken,
+// unless limit is negative
+func detect[S any, K comparable](start S, step func(S) S, key func(S) K, limit int) (Cycle, []S) {
+
+	seen := make(map[K]int)
+
+	states := []S{start}
+
+	for s := start; ; {
+
+		k := key(s)
+
+		if i, ok := seen[k]; ok {
+			return Cycle{Start: i, Length: len(seen) - i}, states[:len(seen)]
+		}
+
+		seen[k] = len(seen)
+
+		if len(seen) > limit && limit >= 0 {
+			return Cycle{}, states
+		}
+
+		s = step(s)
+		states = append(states, s)
+	}
+}
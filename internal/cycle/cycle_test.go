@@ -0,0 +1,108 @@
+package cycle
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+// next walks 0 1 2 3 4 5 then back to 2
+func next(s int) int {
+
+	if s == 5 {
+		return 2
+	}
+
+	return s + 1
+}
+
+func TestCycle(t *testing.T) {
+
+	want := Cycle{Start: 2, Length: 4}
+
+	type test struct {
+		name string
+		find func(int, func(int) int) Cycle
+	}
+
+	tests := []test{
+		{name: "floyd", find: Floyd[int]},
+		{name: "brent", find: Brent[int]},
+		{
+			name: "detect",
+			find: func(start int, step func(int) int) Cycle {
+				c, _ := Detect(start, step, func(s int) int { return s })
+				return c
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.find(0, next); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+
+	type test struct {
+		n    int
+		want int
+		err  error
+	}
+
+	tests := []test{
+		{n: 0, want: 0},
+		{n: 1, want: 1},
+		{n: 5, want: 5},
+		{n: 6, want: 2},
+		{n: 1_000_000_000_000, want: 4},
+		{n: -1, err: ErrNegativeSteps},
+	}
+
+	for _, test := range tests {
+
+		got, err := Extrapolate(0, next, func(s int) int { return s }, test.n)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("got %v, want %v for %v steps", err, test.err, test.n)
+		}
+
+		if got != test.want {
+			t.Errorf("got %v, want %v for %v steps", got, test.want, test.n)
+		}
+	}
+}
+
+func TestBoard(t *testing.T) {
+
+	start := common.ParseRune([]string{
+		"#.",
+		"..",
+	})
+
+	rotate := func(b common.Board[rune]) common.Board[rune] { return b.Rotate(1) }
+
+	want := Cycle{Start: 0, Length: 4}
+
+	if got := BrentFunc(start, rotate, func(a, b common.Board[rune]) bool { return common.Equal(&a, &b) }); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	c, states := Detect(start, rotate, BoardHash[rune])
+
+	if c != want {
+		t.Errorf("got %v, want %v", c, want)
+	}
+
+	got := states[c.Index(1_000_000_001)]
+	b := start.Rotate(1)
+
+	if !common.Equal(&got, &b) {
+		t.Errorf("got a board that is not rotated once")
+	}
+}
@@ -1,58 +0,0 @@
-package day6
-
-import (
-	"testing"
-
-	"github.com/wincus/adventofcode2024/internal/common"
-)
-
-type Test struct {
-	input []string
-	p     common.Part
-	want  int
-}
-
-func TestSolver(t *testing.T) {
-
-	tests := []Test{
-		{
-			input: []string{
-				"....#.....",
-				".........#",
-				"..........",
-				"..#.......",
-				".......#..",
-				"..........",
-				".#..^.....",
-				"........#.",
-				"#.........",
-				"......#...",
-			},
-			p:    common.Part1,
-			want: 41,
-		},
-		{
-			input: []string{
-				"....#.....",
-				".........#",
-				"..........",
-				"..#.......",
-				".......#..",
-				"..........",
-				".#..^.....",
-				"........#.",
-				"#.........",
-				"......#...",
-			}, p: common.Part2,
-			want: 6,
-		},
-	}
-
-	for _, test := range tests {
-		got := Solve(test.input, test.p)
-
-		if got != test.want {
-			t.Errorf("got %v, want %v for part %v", got, test.want, test.p)
-		}
-	}
-}
@@ -0,0 +1,14 @@
+// Code generated by cmd/generate; DO NOT EDIT.
+
+// Package days links every puzzle package into the binary
+// so that their solvers get registered
+package days
+
+import (
+	_ "github.com/wincus/adventofcode2024/internal/2024/day1"
+	_ "github.com/wincus/adventofcode2024/internal/2024/day2"
+	_ "github.com/wincus/adventofcode2024/internal/2024/day3"
+	_ "github.com/wincus/adventofcode2024/internal/2024/day4"
+	_ "github.com/wincus/adventofcode2024/internal/2024/day5"
+	_ "github.com/wincus/adventofcode2024/internal/2024/day6"
+)
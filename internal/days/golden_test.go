@@ -0,0 +1,51 @@
+//go:build golden
+
+package days
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/wincus/adventofcode2024/internal/common"
+)
+
+// TestGolden solves every registered day with your cached input and
+// compares the answers with the golden ones. Run it with:
+//
+//	go test -tags golden ./internal/days
+func TestGolden(t *testing.T) {
+
+	c, err := common.DefaultCache()
+
+	if err != nil {
+		t.Skipf("no cache: %v", err)
+	}
+
+	for _, year := range common.Years() {
+
+		g, err := common.LoadGolden(c.GoldenPath(year))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, day := range common.Days(year) {
+			for _, p := range []common.Part{common.Part1, common.Part2} {
+				t.Run(fmt.Sprintf("%v/day%v/part%v", year, day, p), func(t *testing.T) {
+
+					_, err := common.CheckGolden(context.Background(), c, g, year, day, p)
+
+					if errors.Is(err, common.ErrNoGolden) || errors.Is(err, common.ErrNotCached) {
+						t.Skip(err)
+					}
+
+					if err != nil {
+						t.Error(err)
+					}
+				})
+			}
+		}
+	}
+}